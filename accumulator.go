@@ -0,0 +1,53 @@
+package mathx
+
+import (
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// AtomicAccumulator keeps an exact running total that is safe for concurrent use.
+// The zero value is an empty accumulator ready to use; it must not be copied after first use.
+type AtomicAccumulator struct {
+	mu    sync.Mutex
+	sum   decimal.Decimal
+	count int64
+}
+
+// Add adds d to the total
+func (a *AtomicAccumulator) Add(d decimal.Decimal) {
+	a.mu.Lock()
+	a.sum = a.sum.Add(d)
+	a.count++
+	a.mu.Unlock()
+}
+
+// Sum returns the current total
+func (a *AtomicAccumulator) Sum() decimal.Decimal {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.sum
+}
+
+// Count returns how many values have been added
+func (a *AtomicAccumulator) Count() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.count
+}
+
+// Snapshot returns the total and count as one consistent pair
+func (a *AtomicAccumulator) Snapshot() (sum decimal.Decimal, count int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.sum, a.count
+}
+
+// Reset clears the total and count and returns their previous values
+func (a *AtomicAccumulator) Reset() (sum decimal.Decimal, count int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	sum, count = a.sum, a.count
+	a.sum, a.count = decimal.Decimal{}, 0
+	return sum, count
+}
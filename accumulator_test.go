@@ -0,0 +1,51 @@
+package mathx
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAtomicAccumulator(t *testing.T) {
+	var acc AtomicAccumulator
+	if !acc.Sum().IsZero() || acc.Count() != 0 {
+		t.Fatalf("zero value = %v/%d, want 0/0", acc.Sum(), acc.Count())
+	}
+
+	acc.Add(decimal.RequireFromString("0.1"))
+	acc.Add(decimal.RequireFromString("0.2"))
+	if got := acc.Sum().String(); got != "0.3" {
+		t.Errorf("Sum() = %v, want 0.3", got)
+	}
+
+	sum, count := acc.Reset()
+	if sum.String() != "0.3" || count != 2 {
+		t.Errorf("Reset() = %v, %d, want 0.3, 2", sum, count)
+	}
+	if sum, count := acc.Snapshot(); !sum.IsZero() || count != 0 {
+		t.Errorf("Snapshot() after Reset = %v, %d, want 0, 0", sum, count)
+	}
+}
+
+func TestAtomicAccumulator_concurrent(t *testing.T) {
+	var acc AtomicAccumulator
+	cent := decimal.RequireFromString("0.01")
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				acc.Add(cent)
+			}
+		}()
+	}
+	wg.Wait()
+
+	sum, count := acc.Snapshot()
+	if sum.String() != "80" || count != 8000 {
+		t.Errorf("Snapshot() = %v, %d, want 80, 8000", sum, count)
+	}
+}
@@ -0,0 +1,144 @@
+package mathx
+
+import "github.com/shopspring/decimal"
+
+// amortizationPlaces is the number of decimal places (cents) amortization amounts are rounded to
+const amortizationPlaces = 2
+
+// AmortizationEntry is one period of an amortization schedule
+type AmortizationEntry struct {
+	Period    int             // 1-based period number
+	Payment   decimal.Decimal // total paid in the period: Interest + Principal + Extra
+	Interest  decimal.Decimal // interest charged for the period
+	Principal decimal.Decimal // scheduled principal repaid
+	Extra     decimal.Decimal // extra principal repaid
+	Balance   decimal.Decimal // remaining balance after the payment
+}
+
+// ExtraPayment is an additional principal payment. It is made once in Period (1-based),
+// or, when Every is positive, in Period and every Every periods after it.
+type ExtraPayment struct {
+	Period int
+	Amount float64
+	Every  int
+}
+
+// Amortization is the result of AmortizeWithExtra
+type Amortization struct {
+	Schedule      []AmortizationEntry
+	TotalInterest decimal.Decimal // interest paid with the extra payments
+	InterestSaved decimal.Decimal // interest saved compared to the regular schedule
+	PeriodsSaved  int             // how many periods earlier the loan is paid off
+}
+
+// LoanPayment returns the fixed payment per period that repays principal over periods
+// at the given rate per period, rounded to cents. It returns ErrDomain if principal or
+// rate is negative or periods is not positive.
+func LoanPayment(principal, rate float64, periods int) (Result, error) {
+	p, r, err := loanArgs(principal, rate, periods)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{v: loanPayment(p, r, periods)}, nil
+}
+
+// AmortizationSchedule returns the regular amortization schedule of a loan with a fixed
+// payment per period. Interest is rounded to cents each period and the last payment
+// absorbs any rounding difference so the balance ends at zero.
+func AmortizationSchedule(principal, rate float64, periods int) ([]AmortizationEntry, error) {
+	p, r, err := loanArgs(principal, rate, periods)
+	if err != nil {
+		return nil, err
+	}
+	return amortize(p, r, periods, nil), nil
+}
+
+// AmortizeWithExtra returns the amortization schedule of a loan with extra principal
+// payments, together with the interest saved and the number of periods saved compared
+// to the regular schedule. Extra payments never exceed the remaining balance.
+func AmortizeWithExtra(principal, rate float64, periods int, extras ...ExtraPayment) (Amortization, error) {
+	p, r, err := loanArgs(principal, rate, periods)
+	if err != nil {
+		return Amortization{}, err
+	}
+	for _, e := range extras {
+		if e.Period < 1 || e.Amount < 0 || e.Every < 0 {
+			return Amortization{}, ErrDomain
+		}
+	}
+
+	regular := amortize(p, r, periods, nil)
+	schedule := amortize(p, r, periods, extras)
+	total := totalInterest(schedule)
+	return Amortization{
+		Schedule:      schedule,
+		TotalInterest: total,
+		InterestSaved: totalInterest(regular).Sub(total),
+		PeriodsSaved:  len(regular) - len(schedule),
+	}, nil
+}
+
+// loanArgs validates and converts the loan arguments
+func loanArgs(principal, rate float64, periods int) (decimal.Decimal, decimal.Decimal, error) {
+	if principal < 0 || rate < 0 || periods <= 0 {
+		return decimal.Zero, decimal.Zero, ErrDomain
+	}
+	return decimal.NewFromFloat(principal), decimal.NewFromFloat(rate), nil
+}
+
+// loanPayment returns P*r / (1 - (1+r)^-n) rounded to cents, or P/n when r is zero
+func loanPayment(p, r decimal.Decimal, n int) decimal.Decimal {
+	if r.IsZero() {
+		return p.DivRound(decimal.NewFromInt(int64(n)), amortizationPlaces)
+	}
+	growth, _ := decimalOne.Add(r).PowInt32(int32(n))
+	// P*r*g / (g - 1) is the same as P*r / (1 - g^-n) without the extra division
+	return p.Mul(r).Mul(growth).DivRound(growth.Sub(decimalOne), divisionPlaces).Round(amortizationPlaces)
+}
+
+// amortize builds the schedule, stopping once the balance reaches zero
+func amortize(p, r decimal.Decimal, n int, extras []ExtraPayment) []AmortizationEntry {
+	payment := loanPayment(p, r, n)
+	balance := p
+	schedule := make([]AmortizationEntry, 0, n)
+	for period := 1; period <= n && balance.IsPositive(); period++ {
+		interest := balance.Mul(r).Round(amortizationPlaces)
+		principal := payment.Sub(interest)
+		if period == n || principal.GreaterThan(balance) {
+			principal = balance
+		}
+		extra := decimal.Min(extraFor(extras, period), balance.Sub(principal))
+		balance = balance.Sub(principal).Sub(extra)
+		schedule = append(schedule, AmortizationEntry{
+			Period:    period,
+			Payment:   interest.Add(principal).Add(extra),
+			Interest:  interest,
+			Principal: principal,
+			Extra:     extra,
+			Balance:   balance,
+		})
+	}
+	return schedule
+}
+
+// extraFor returns the total extra payment due in period
+func extraFor(extras []ExtraPayment, period int) decimal.Decimal {
+	sum := decimal.Zero
+	for _, e := range extras {
+		due := period == e.Period ||
+			(e.Every > 0 && period > e.Period && (period-e.Period)%e.Every == 0)
+		if due {
+			sum = sum.Add(decimal.NewFromFloat(e.Amount))
+		}
+	}
+	return sum
+}
+
+// totalInterest sums the interest column of a schedule
+func totalInterest(schedule []AmortizationEntry) decimal.Decimal {
+	sum := decimal.Zero
+	for _, e := range schedule {
+		sum = sum.Add(e.Interest)
+	}
+	return sum
+}
@@ -0,0 +1,114 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestLoanPayment(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal float64
+		rate      float64
+		periods   int
+		expected  string
+	}{
+		{"30 year mortgage", 100000, 0.005, 360, "599.55"},
+		{"one year", 1000, 0.01, 12, "88.85"},
+		{"zero rate", 1200, 0, 12, "100"},
+		{"single period", 1000, 0.05, 1, "1050"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoanPayment(tt.principal, tt.rate, tt.periods)
+			if err != nil {
+				t.Fatalf("LoanPayment() error = %v", err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("LoanPayment() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	for _, args := range [][3]float64{{-1, 0.01, 12}, {1000, -0.01, 12}, {1000, 0.01, 0}} {
+		if _, err := LoanPayment(args[0], args[1], int(args[2])); !errors.Is(err, ErrDomain) {
+			t.Errorf("LoanPayment(%v) error = %v, want ErrDomain", args, err)
+		}
+	}
+}
+
+func TestAmortizationSchedule(t *testing.T) {
+	schedule, err := AmortizationSchedule(1000, 0.01, 12)
+	if err != nil {
+		t.Fatalf("AmortizationSchedule() error = %v", err)
+	}
+	if len(schedule) != 12 {
+		t.Fatalf("AmortizationSchedule() has %d periods, want 12", len(schedule))
+	}
+
+	first, last := schedule[0], schedule[11]
+	if first.Interest.String() != "10" || first.Principal.String() != "78.85" || first.Balance.String() != "921.15" {
+		t.Errorf("first entry = %+v", first)
+	}
+	if last.Payment.String() != "88.84" || !last.Balance.IsZero() {
+		t.Errorf("last entry = %+v, want payment 88.84 and zero balance", last)
+	}
+
+	repaid := decimal.Zero
+	for _, e := range schedule {
+		repaid = repaid.Add(e.Principal)
+	}
+	if !repaid.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("principal repaid = %v, want 1000", repaid)
+	}
+	if got := totalInterest(schedule).String(); got != "66.19" {
+		t.Errorf("total interest = %v, want 66.19", got)
+	}
+}
+
+func TestAmortizeWithExtra(t *testing.T) {
+	tests := []struct {
+		name          string
+		extras        []ExtraPayment
+		periods       int
+		totalInterest string
+		interestSaved string
+		periodsSaved  int
+	}{
+		{"no extras", nil, 12, "66.19", "0", 0},
+		{"one-time", []ExtraPayment{{Period: 1, Amount: 200}}, 10, "44.83", "21.36", 2},
+		{"recurring", []ExtraPayment{{Period: 1, Amount: 100, Every: 3}}, 9, "45.55", "20.64", 3},
+		{"payoff in first period", []ExtraPayment{{Period: 1, Amount: 5000}}, 1, "10", "56.19", 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AmortizeWithExtra(1000, 0.01, 12, tt.extras...)
+			if err != nil {
+				t.Fatalf("AmortizeWithExtra() error = %v", err)
+			}
+			if len(got.Schedule) != tt.periods {
+				t.Errorf("periods = %d, want %d", len(got.Schedule), tt.periods)
+			}
+			if got.TotalInterest.String() != tt.totalInterest {
+				t.Errorf("TotalInterest = %v, want %v", got.TotalInterest, tt.totalInterest)
+			}
+			if got.InterestSaved.String() != tt.interestSaved {
+				t.Errorf("InterestSaved = %v, want %v", got.InterestSaved, tt.interestSaved)
+			}
+			if got.PeriodsSaved != tt.periodsSaved {
+				t.Errorf("PeriodsSaved = %d, want %d", got.PeriodsSaved, tt.periodsSaved)
+			}
+			if last := got.Schedule[len(got.Schedule)-1]; !last.Balance.IsZero() {
+				t.Errorf("final balance = %v, want 0", last.Balance)
+			}
+		})
+	}
+
+	if _, err := AmortizeWithExtra(1000, 0.01, 12, ExtraPayment{Period: 0, Amount: 10}); !errors.Is(err, ErrDomain) {
+		t.Errorf("AmortizeWithExtra() error = %v, want ErrDomain", err)
+	}
+}
@@ -0,0 +1,66 @@
+package mathx
+
+import (
+	"math"
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+// Apportion distributes an integer total proportionally to weights using the
+// largest-remainder (Hamilton) method. Every part receives the integer part of its
+// exact quota and the leftover units go to the largest fractional remainders,
+// earlier indexes winning ties, so the parts always sum to total.
+// Negative, NaN and infinite weights count as zero; if no weight is positive the
+// total is split as evenly as possible. A negative total yields negative parts.
+func Apportion(total int64, weights []float64) []int64 {
+	if len(weights) == 0 {
+		return nil
+	}
+
+	ws := make([]decimal.Decimal, len(weights))
+	sum := decimal.Zero
+	for i, w := range weights {
+		if w > 0 && !math.IsInf(w, 1) {
+			ws[i] = decimal.NewFromFloat(w)
+			sum = sum.Add(ws[i])
+		}
+	}
+	if sum.IsZero() {
+		for i := range ws {
+			ws[i] = decimalOne
+		}
+		sum = decimal.NewFromInt(int64(len(ws)))
+	}
+
+	neg := total < 0
+	t := decimal.NewFromInt(total).Abs()
+	parts := make([]int64, len(ws))
+	rems := make([]decimal.Decimal, len(ws))
+	left := t
+	for i, w := range ws {
+		quota := t.Mul(w).DivRound(sum, divisionPlaces)
+		whole := quota.Floor()
+		parts[i] = whole.IntPart()
+		rems[i] = quota.Sub(whole)
+		left = left.Sub(whole)
+	}
+
+	order := make([]int, len(ws))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		return rems[j].Cmp(rems[i])
+	})
+	for k := int64(0); k < left.IntPart(); k++ {
+		parts[order[k%int64(len(order))]]++
+	}
+
+	if neg {
+		for i := range parts {
+			parts[i] = -parts[i]
+		}
+	}
+	return parts
+}
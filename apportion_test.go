@@ -0,0 +1,38 @@
+package mathx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestApportion(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int64
+		weights  []float64
+		expected []int64
+	}{
+		{"exact", 10, []float64{1, 1}, []int64{5, 5}},
+		{"largest remainder", 10, []float64{1, 1, 1}, []int64{4, 3, 3}},
+		{"seats", 100, []float64{47000, 16000, 15800, 12000, 6100, 3100}, []int64{47, 16, 16, 12, 6, 3}},
+		{"remainders favor larger fraction", 7, []float64{0.25, 0.35, 0.4}, []int64{2, 2, 3}},
+		{"zero weight", 5, []float64{0, 1, 1}, []int64{0, 3, 2}},
+		{"negative weight ignored", 4, []float64{-1, 1, 3}, []int64{0, 1, 3}},
+		{"all zero weights", 5, []float64{0, 0}, []int64{3, 2}},
+		{"negative total", -10, []float64{1, 1, 1}, []int64{-4, -3, -3}},
+		{"zero total", 0, []float64{1, 2}, []int64{0, 0}},
+		{"empty", 10, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Apportion(tt.total, tt.weights)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Apportion() = %v, want %v", got, tt.expected)
+			}
+			if len(got) > 0 && Sum(got...) != tt.total {
+				t.Errorf("Apportion() parts sum to %d, want %d", Sum(got...), tt.total)
+			}
+		})
+	}
+}
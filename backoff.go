@@ -0,0 +1,39 @@
+package mathx
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Backoff returns the delay before retry number attempt (starting at 0) of an
+// exponential backoff: base * factor^attempt, capped at max. The unit is the caller's,
+// e.g. seconds or milliseconds. A negative attempt is treated as 0, and a delay that
+// overflows is capped like any other.
+func Backoff(base, factor float64, attempt int, max float64) float64 {
+	if attempt < 0 {
+		attempt = 0
+	}
+	delay := base * math.Pow(factor, float64(attempt))
+	if math.IsNaN(delay) || delay > max {
+		return max
+	}
+	return delay
+}
+
+// JitteredBackoff returns the Backoff delay reduced by a random fraction of up to
+// jitter of itself, so clients retrying together spread out. jitter is clamped to
+// [0, 1]: 0 gives the plain Backoff delay and 1 gives "full jitter", a delay drawn
+// uniformly from [0, delay). rng supplies the randomness so tests can seed it; if nil,
+// the shared source of math/rand is used.
+func JitteredBackoff(base, factor float64, attempt int, max, jitter float64, rng *rand.Rand) float64 {
+	delay := Backoff(base, factor, attempt, max)
+	jitter = Clamp(jitter, 0, 1)
+	if jitter == 0 {
+		return delay
+	}
+	u := rand.Float64
+	if rng != nil {
+		u = rng.Float64
+	}
+	return delay * (1 - jitter*u())
+}
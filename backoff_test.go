@@ -0,0 +1,54 @@
+package mathx
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name              string
+		base, factor, max float64
+		attempt           int
+		want              float64
+	}{
+		{"first attempt", 100, 2, 10000, 0, 100},
+		{"doubling", 100, 2, 10000, 3, 800},
+		{"capped", 100, 2, 10000, 10, 10000},
+		{"overflow", 1, 10, 60, 400, 60},
+		{"negative attempt", 0.5, 3, 30, -2, 0.5},
+		{"gentle factor", 1, 1.5, 30, 2, 2.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Backoff(tt.base, tt.factor, tt.attempt, tt.max); got != tt.want {
+				t.Errorf("Backoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJitteredBackoff(t *testing.T) {
+	if got := JitteredBackoff(100, 2, 3, 10000, 0, nil); got != 800 {
+		t.Errorf("JitteredBackoff() without jitter = %v, want 800", got)
+	}
+
+	for _, jitter := range []float64{0.25, 1, 7} {
+		rng := rand.New(rand.NewSource(1))
+		lo := 800 * (1 - math.Min(jitter, 1))
+		for range 200 {
+			got := JitteredBackoff(100, 2, 3, 10000, jitter, rng)
+			if got < lo || got > 800 {
+				t.Fatalf("JitteredBackoff(jitter %v) = %v, outside [%v, 800]", jitter, got, lo)
+			}
+		}
+	}
+
+	a := JitteredBackoff(1, 2, 5, 60, 0.5, rand.New(rand.NewSource(7)))
+	b := JitteredBackoff(1, 2, 5, 60, 0.5, rand.New(rand.NewSource(7)))
+	if a != b {
+		t.Errorf("JitteredBackoff() = %v and %v, want equal for the same seed", a, b)
+	}
+}
@@ -0,0 +1,118 @@
+package mathx
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// SumDecimalInto stores the sum of ds in dst. Coefficients are accumulated in a single
+// integer at the smallest exponent, so no intermediate decimal is allocated per element.
+func SumDecimalInto(dst *decimal.Decimal, ds ...decimal.Decimal) {
+	*dst = sumDecimals(ds)
+}
+
+// ScaleDecimalsInto multiplies every value of src by factor and appends the results
+// to dst[:0], reusing its capacity
+func ScaleDecimalsInto(dst, src []decimal.Decimal, factor decimal.Decimal) []decimal.Decimal {
+	dst = dst[:0]
+	for _, d := range src {
+		dst = append(dst, d.Mul(factor))
+	}
+	return dst
+}
+
+// RoundDecimalsInto rounds every value of src to places and appends the results
+// to dst[:0], reusing its capacity
+func RoundDecimalsInto(dst, src []decimal.Decimal, places int32) []decimal.Decimal {
+	dst = dst[:0]
+	for _, d := range src {
+		dst = append(dst, d.Round(places))
+	}
+	return dst
+}
+
+// sumDecimals adds ds using an int64 accumulator while it does not overflow and
+// a big.Int otherwise
+func sumDecimals(ds []decimal.Decimal) decimal.Decimal {
+	if len(ds) == 0 {
+		return decimal.Zero
+	}
+	minExp := ds[0].Exponent()
+	for _, d := range ds[1:] {
+		minExp = min(minExp, d.Exponent())
+	}
+
+	var acc *big.Int
+	var sum int64
+	flush := func() {
+		if acc == nil {
+			acc = new(big.Int)
+		}
+		acc.Add(acc, big.NewInt(sum))
+		sum = 0
+	}
+	for _, d := range ds {
+		shift := d.Exponent() - minExp
+		if c, ok := smallCoefficient(d); ok && int(shift) < len(int64pow10) {
+			if scaled, ok := mulInt64(c, int64pow10[shift]); ok {
+				if s, ok := addInt64(sum, scaled); ok {
+					sum = s
+					continue
+				}
+				flush()
+				sum = scaled
+				continue
+			}
+		}
+		if acc == nil {
+			acc = new(big.Int)
+		}
+		scaled := d.Coefficient()
+		if shift > 0 {
+			scaled.Mul(scaled, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil))
+		}
+		acc.Add(acc, scaled)
+	}
+	if acc == nil {
+		return decimal.New(sum, minExp)
+	}
+	flush()
+	return decimal.NewFromBigInt(acc, minExp)
+}
+
+// int64pow10 holds the powers of ten that fit in an int64
+var int64pow10 = [...]int64{
+	1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10, 1e11, 1e12,
+	1e13, 1e14, 1e15, 1e16, 1e17, 1e18,
+}
+
+// smallCoefficient returns the coefficient of d if it certainly fits in an int64
+func smallCoefficient(d decimal.Decimal) (int64, bool) {
+	if d.NumDigits() > 18 {
+		return 0, false
+	}
+	return d.CoefficientInt64(), true
+}
+
+// addInt64 adds two int64 values and reports whether the result did not overflow
+func addInt64(a, b int64) (int64, bool) {
+	s := a + b
+	if (s > a) != (b > 0) {
+		return 0, false
+	}
+	return s, true
+}
+
+// mulInt64 multiplies two int64 values and reports whether the result did not overflow
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) || p/b != a {
+		return 0, false
+	}
+	return p, true
+}
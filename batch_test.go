@@ -0,0 +1,74 @@
+package mathx
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestSumDecimalInto(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected string
+	}{
+		{"empty", nil, "0"},
+		{"single", []string{"1.5"}, "1.5"},
+		{"mixed scales", []string{"0.1", "0.02", "3"}, "3.12"},
+		{"negative", []string{"-1.25", "1", "-0.75"}, "-1"},
+		{"int64 overflow", []string{"9223372036854775807", "9223372036854775807"}, "18446744073709551614"},
+		{"big coefficient", []string{"123456789012345678901234567890.1", "0.9"}, "123456789012345678901234567891"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := make([]decimal.Decimal, len(tt.values))
+			for i, v := range tt.values {
+				ds[i] = decimal.RequireFromString(v)
+			}
+			var got decimal.Decimal
+			SumDecimalInto(&got, ds...)
+			if !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("SumDecimalInto() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSumDecimalInto_matchesAdd(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	for i := 0; i < 200; i++ {
+		ds := make([]decimal.Decimal, rng.Intn(50))
+		want := decimal.Zero
+		for j := range ds {
+			ds[j] = decimal.New(rng.Int63()-rng.Int63(), int32(rng.Intn(12)-8))
+			want = want.Add(ds[j])
+		}
+		var got decimal.Decimal
+		SumDecimalInto(&got, ds...)
+		if !got.Equal(want) {
+			t.Fatalf("SumDecimalInto() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestScaleDecimalsInto(t *testing.T) {
+	src := []decimal.Decimal{decimal.RequireFromString("1.5"), decimal.RequireFromString("-2")}
+	dst := make([]decimal.Decimal, 0, 2)
+	got := ScaleDecimalsInto(dst, src, decimal.NewFromInt(3))
+	if len(got) != 2 || got[0].String() != "4.5" || got[1].String() != "-6" {
+		t.Errorf("ScaleDecimalsInto() = %v, want [4.5 -6]", got)
+	}
+	if &got[0] != &dst[:1][0] {
+		t.Errorf("ScaleDecimalsInto() did not reuse dst")
+	}
+}
+
+func TestRoundDecimalsInto(t *testing.T) {
+	src := []decimal.Decimal{decimal.RequireFromString("1.555"), decimal.RequireFromString("-2.444")}
+	got := RoundDecimalsInto(nil, src, 2)
+	if len(got) != 2 || got[0].String() != "1.56" || got[1].String() != "-2.44" {
+		t.Errorf("RoundDecimalsInto() = %v, want [1.56 -2.44]", got)
+	}
+}
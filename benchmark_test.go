@@ -1,6 +1,7 @@
 package mathx
 
 import (
+	"io"
 	"math"
 	"testing"
 
@@ -37,6 +38,13 @@ func BenchmarkRound(b *testing.B) {
 	}
 }
 
+func BenchmarkFRound(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FRound(3.14159, 2)
+	}
+}
+
 func BenchmarkTruncate(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		Truncate(3.14159, 2)
@@ -73,7 +81,15 @@ func BenchmarkSqrt(b *testing.B) {
 	}
 }
 
+func BenchmarkLerp(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Lerp(10.5, 20.25, 0.3)
+	}
+}
+
 func BenchmarkAverage(b *testing.B) {
+	b.ReportAllocs()
 	values := []float64{1, 2, 3, 4, 5}
 	for i := 0; i < b.N; i++ {
 		Average(values...)
@@ -81,6 +97,7 @@ func BenchmarkAverage(b *testing.B) {
 }
 
 func BenchmarkStandardDeviation(b *testing.B) {
+	b.ReportAllocs()
 	values := []float64{1, 2, 3, 4, 5}
 	for i := 0; i < b.N; i++ {
 		StandardDeviation(values...)
@@ -108,6 +125,18 @@ func BenchmarkSum(b *testing.B) {
 	}
 }
 
+func BenchmarkSumSafe(b *testing.B) {
+	b.ReportAllocs()
+	values := make([]decimal.Decimal, 1000)
+	for i := range values {
+		values[i] = decimal.New(int64(i*137), -2)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SumSafe(values...)
+	}
+}
+
 func BenchmarkDivSafe(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		DivSafe(decimal.NewFromFloat(10.0), decimal.NewFromFloat(2.0), 2)
@@ -121,17 +150,101 @@ func BenchmarkToString(b *testing.B) {
 }
 
 func BenchmarkToStringFixed(b *testing.B) {
+	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		ToStringFixed(3.14159, 2)
 	}
 }
 
+func BenchmarkAppendFixed(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = AppendFixed(buf[:0], 3.14159, 2)
+	}
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = AppendString(buf[:0], 3.14159)
+	}
+}
+
 func BenchmarkFormatMoney(b *testing.B) {
+	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		FormatMoney(1234567.89, 2)
 	}
 }
 
+func BenchmarkAppendMoney(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = AppendMoney(buf[:0], 1234567.89, 2)
+	}
+}
+
+func BenchmarkWriteMoney(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		WriteMoney(io.Discard, 1234567.89, 2)
+	}
+}
+
+func BenchmarkWriteFixed(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		WriteFixed(io.Discard, 3.14159, 2)
+	}
+}
+
+func BenchmarkFormatMoney_parallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			FormatMoney(1234567.89, 2)
+		}
+	})
+}
+
+func BenchmarkToStringFixed_parallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			ToStringFixed(3.14159, 2)
+		}
+	})
+}
+
+func BenchmarkAppendMoney_parallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		buf := make([]byte, 0, 64)
+		for pb.Next() {
+			buf = AppendMoney(buf[:0], 1234567.89, 2)
+		}
+	})
+}
+
+func BenchmarkWriteMoney_parallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			WriteMoney(io.Discard, 1234567.89, 2)
+		}
+	})
+}
+
+func BenchmarkFormatMoneyInt(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FormatMoneyInt(1234567, 2)
+	}
+}
+
 func BenchmarkParseFloat(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		ParseFloat("3.14159")
@@ -171,11 +284,34 @@ func BenchmarkResult_ToStringFixed(b *testing.B) {
 
 func BenchmarkResult_Clean(b *testing.B) {
 	result := NewResult(3.14000)
+	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		result.Clean()
 	}
 }
 
+func BenchmarkResult_CleanLarge(b *testing.B) {
+	result := MustResultFromString("12345678901234567890.1234500000000000")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		result.Clean()
+	}
+}
+
+func BenchmarkRemoveTrailingZeros(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		RemoveTrailingZeros(1234.5)
+	}
+}
+
+func BenchmarkRemoveTrailingZerosFixed(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		RemoveTrailingZerosFixed(1234.5, 4)
+	}
+}
+
 func BenchmarkResult_Round(b *testing.B) {
 	result := NewResult(3.14159)
 	for i := 0; i < b.N; i++ {
@@ -191,12 +327,22 @@ func BenchmarkResult_Truncate(b *testing.B) {
 }
 
 func BenchmarkResult_FormatMoney(b *testing.B) {
+	b.ReportAllocs()
 	result := NewResult(1234567.89)
 	for i := 0; i < b.N; i++ {
 		result.FormatMoney(2)
 	}
 }
 
+func BenchmarkResult_AppendMoney(b *testing.B) {
+	b.ReportAllocs()
+	result := NewResult(1234567.89)
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = result.AppendMoney(buf[:0], 2)
+	}
+}
+
 func BenchmarkResult_Add(b *testing.B) {
 	result := NewResult(3.14)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,47 @@
+package mathx
+
+import "math"
+
+// BenfordCriticalValue is the chi-square critical value for 8 degrees of freedom at the
+// 5% significance level. A BenfordResult.ChiSquare above it suggests the first digits
+// do not follow Benford's law.
+const BenfordCriticalValue = 15.507
+
+// BenfordResult compares the first-digit distribution of a dataset with Benford's law.
+// Index 0 of the arrays is digit 1, index 8 is digit 9.
+type BenfordResult struct {
+	Count     int        // number of values with a leading digit, i.e. finite and non-zero
+	Observed  [9]float64 // observed frequency of each first digit
+	Expected  [9]float64 // frequency predicted by Benford's law, log10(1 + 1/d)
+	ChiSquare float64    // Pearson chi-square statistic over the digit counts
+}
+
+// BenfordDistribution tallies the first significant digit of values and compares the
+// frequencies with Benford's law. Zero, NaN and infinite values are skipped, and the
+// sign is ignored. With no usable values the observed frequencies and ChiSquare are 0.
+func BenfordDistribution(values []float64) BenfordResult {
+	var counts [9]int
+	var res BenfordResult
+	var buf [32]byte
+	for _, v := range values {
+		if v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		digits, _ := floatDigits(buf[:0], v)
+		counts[digits[0]-'1']++
+		res.Count++
+	}
+
+	n := float64(res.Count)
+	for i := range res.Expected {
+		res.Expected[i] = math.Log10(1 + 1/float64(i+1))
+		if res.Count == 0 {
+			continue
+		}
+		res.Observed[i] = float64(counts[i]) / n
+		expected := res.Expected[i] * n
+		diff := float64(counts[i]) - expected
+		res.ChiSquare += diff * diff / expected
+	}
+	return res
+}
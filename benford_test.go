@@ -0,0 +1,50 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBenfordDistribution(t *testing.T) {
+	res := BenfordDistribution([]float64{1, 15, -0.0123, 2e10, 9.99, 0, math.NaN(), math.Inf(1)})
+	if res.Count != 5 {
+		t.Fatalf("Count = %d, want 5", res.Count)
+	}
+	want := [9]float64{0.6, 0.2, 0, 0, 0, 0, 0, 0, 0.2}
+	if res.Observed != want {
+		t.Errorf("Observed = %v, want %v", res.Observed, want)
+	}
+	if math.Abs(res.Expected[0]-0.30103) > 1e-5 || math.Abs(res.Expected[8]-0.04576) > 1e-5 {
+		t.Errorf("Expected = %v, want log10(1 + 1/d)", res.Expected)
+	}
+	var sum float64
+	for _, e := range res.Expected {
+		sum += e
+	}
+	if math.Abs(sum-1) > 1e-12 {
+		t.Errorf("Expected sums to %v, want 1", sum)
+	}
+}
+
+func TestBenfordDistribution_chiSquare(t *testing.T) {
+	// powers of two are known to follow Benford's law closely
+	powers := make([]float64, 1000)
+	for i := range powers {
+		powers[i] = math.Pow(2, float64(i))
+	}
+	if res := BenfordDistribution(powers); res.ChiSquare > BenfordCriticalValue {
+		t.Errorf("ChiSquare of powers of two = %v, want <= %v", res.ChiSquare, BenfordCriticalValue)
+	}
+
+	uniform := make([]float64, 900)
+	for i := range uniform {
+		uniform[i] = float64(i%9 + 1)
+	}
+	if res := BenfordDistribution(uniform); res.ChiSquare <= BenfordCriticalValue {
+		t.Errorf("ChiSquare of uniform digits = %v, want > %v", res.ChiSquare, BenfordCriticalValue)
+	}
+
+	if res := BenfordDistribution(nil); res.Count != 0 || res.ChiSquare != 0 || res.Expected[0] == 0 {
+		t.Errorf("BenfordDistribution(nil) = %+v, want zero counts with expected frequencies", res)
+	}
+}
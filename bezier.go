@@ -0,0 +1,55 @@
+package mathx
+
+// QuadraticBezier evaluates the quadratic Bézier curve with control values p0, p1, p2 at t
+func QuadraticBezier(p0, p1, p2, t float64) float64 {
+	u := 1 - t
+	return u*u*p0 + 2*u*t*p1 + t*t*p2
+}
+
+// CubicBezier evaluates the cubic Bézier curve with control values p0..p3 at t
+func CubicBezier(p0, p1, p2, p3, t float64) float64 {
+	u := 1 - t
+	return u*u*u*p0 + 3*u*u*t*p1 + 3*u*t*t*p2 + t*t*t*p3
+}
+
+// QuadraticBezierPoint evaluates the quadratic Bézier curve with control points p0, p1, p2 at t
+func QuadraticBezierPoint(p0, p1, p2 Point, t float64) Point {
+	return Point{
+		X: QuadraticBezier(p0.X, p1.X, p2.X, t),
+		Y: QuadraticBezier(p0.Y, p1.Y, p2.Y, t),
+	}
+}
+
+// CubicBezierPoint evaluates the cubic Bézier curve with control points p0..p3 at t
+func CubicBezierPoint(p0, p1, p2, p3 Point, t float64) Point {
+	return Point{
+		X: CubicBezier(p0.X, p1.X, p2.X, p3.X, t),
+		Y: CubicBezier(p0.Y, p1.Y, p2.Y, p3.Y, t),
+	}
+}
+
+// CubicBezierEasing returns an easing function shaped like CSS cubic-bezier(x1, y1, x2, y2):
+// a cubic Bézier from (0, 0) to (1, 1) with control points (x1, y1) and (x2, y2).
+// x1 and x2 are clamped to [0, 1] so the curve is a function of time.
+func CubicBezierEasing(x1, y1, x2, y2 float64) EasingFunc {
+	x1, x2 = Clamp(x1, 0, 1), Clamp(x2, 0, 1)
+	return func(x float64) float64 {
+		x = Clamp(x, 0, 1)
+		// x(t) is monotonic, so bisection always finds the parameter for x
+		lo, hi := 0.0, 1.0
+		t := x
+		for i := 0; i < 64; i++ {
+			cx := CubicBezier(0, x1, x2, 1, t)
+			if cx == x {
+				break
+			}
+			if cx < x {
+				lo = t
+			} else {
+				hi = t
+			}
+			t = (lo + hi) / 2
+		}
+		return CubicBezier(0, y1, y2, 1, t)
+	}
+}
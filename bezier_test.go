@@ -0,0 +1,72 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuadraticBezier(t *testing.T) {
+	tests := []struct {
+		t        float64
+		expected float64
+	}{
+		{0, 0},
+		{0.5, 7.5},
+		{1, 10},
+	}
+	for _, tt := range tests {
+		if got := QuadraticBezier(0, 10, 10, tt.t); math.Abs(got-tt.expected) > 1e-12 {
+			t.Errorf("QuadraticBezier(%v) = %v, want %v", tt.t, got, tt.expected)
+		}
+	}
+}
+
+func TestCubicBezier(t *testing.T) {
+	tests := []struct {
+		t        float64
+		expected float64
+	}{
+		{0, 1},
+		{0.5, 2.5},
+		{1, 4},
+	}
+	for _, tt := range tests {
+		if got := CubicBezier(1, 2, 3, 4, tt.t); math.Abs(got-tt.expected) > 1e-12 {
+			t.Errorf("CubicBezier(%v) = %v, want %v", tt.t, got, tt.expected)
+		}
+	}
+	// a straight control polygon reduces to Lerp
+	for _, x := range []float64{0.1, 0.25, 0.9} {
+		if got := CubicBezier(0, 1.0/3, 2.0/3, 1, x); math.Abs(got-x) > 1e-12 {
+			t.Errorf("linear CubicBezier(%v) = %v", x, got)
+		}
+	}
+}
+
+func TestBezierPoint(t *testing.T) {
+	p := QuadraticBezierPoint(Point{0, 0}, Point{1, 2}, Point{2, 0}, 0.5)
+	if p != (Point{1, 1}) {
+		t.Errorf("QuadraticBezierPoint() = %v, want {1 1}", p)
+	}
+	p = CubicBezierPoint(Point{0, 0}, Point{0, 1}, Point{1, 1}, Point{1, 0}, 0.5)
+	if p != (Point{0.5, 0.75}) {
+		t.Errorf("CubicBezierPoint() = %v, want {0.5 0.75}", p)
+	}
+}
+
+func TestCubicBezierEasing(t *testing.T) {
+	linear := CubicBezierEasing(0, 0, 1, 1)
+	ease := CubicBezierEasing(0.25, 0.1, 0.25, 1) // CSS "ease"
+
+	for _, x := range []float64{0, 0.2, 0.5, 0.8, 1} {
+		if got := linear(x); math.Abs(got-x) > 1e-9 {
+			t.Errorf("linear(%v) = %v", x, got)
+		}
+	}
+	if got := ease(0.5); math.Abs(got-0.8024033877399112) > 1e-6 {
+		t.Errorf("ease(0.5) = %v, want about 0.8024", got)
+	}
+	if ease(0) != 0 || ease(1) != 1 || ease(-1) != 0 || ease(2) != 1 {
+		t.Error("ease should map the ends to 0 and 1 and clamp its input")
+	}
+}
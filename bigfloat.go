@@ -0,0 +1,69 @@
+package mathx
+
+import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// BigFloatAdd returns a + b as a new big.Float with the larger precision of a and b.
+// Like big.Float.Add it panics with big.ErrNaN for infinities of opposite sign.
+func BigFloatAdd(a, b *big.Float) *big.Float {
+	return newBigFloatFor(a, b).Add(a, b)
+}
+
+// BigFloatSub returns a - b as a new big.Float with the larger precision of a and b.
+// Like big.Float.Sub it panics with big.ErrNaN for infinities of equal sign.
+func BigFloatSub(a, b *big.Float) *big.Float {
+	return newBigFloatFor(a, b).Sub(a, b)
+}
+
+// BigFloatMul returns a * b as a new big.Float with the larger precision of a and b.
+// The operands are never converted to float64. Like big.Float.Mul it panics with
+// big.ErrNaN for zero times infinity.
+func BigFloatMul(a, b *big.Float) *big.Float {
+	return newBigFloatFor(a, b).Mul(a, b)
+}
+
+// BigFloatDiv returns a / b as a new big.Float with the larger precision of a and b,
+// or ErrDivisionByZero if b is zero
+func BigFloatDiv(a, b *big.Float) (*big.Float, error) {
+	if b.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	if a.IsInf() && b.IsInf() {
+		return nil, ErrNotFinite
+	}
+	return newBigFloatFor(a, b).Quo(a, b), nil
+}
+
+// BigFloatCmp compares a and b and returns -1, 0 or +1
+func BigFloatCmp(a, b *big.Float) int {
+	return a.Cmp(b)
+}
+
+// newBigFloatFor returns a zero big.Float with the precision and rounding mode
+// the result of an operation on a and b should use
+func newBigFloatFor(a, b *big.Float) *big.Float {
+	return new(big.Float).SetPrec(max(a.Prec(), b.Prec())).SetMode(a.Mode())
+}
+
+// NewResultFromBigFloat creates a Result from f using the shortest decimal that
+// identifies f at its precision. It returns ErrNotFinite for ±Inf.
+func NewResultFromBigFloat(f *big.Float) (Result, error) {
+	if f.IsInf() {
+		return Result{}, ErrNotFinite
+	}
+	d, err := decimal.NewFromString(f.Text('g', -1))
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{v: d}, nil
+}
+
+// BigFloat converts the result to a big.Float with prec bits of mantissa, rounding to
+// nearest even. A prec of 0 picks enough bits for the decimal's numerator and
+// denominator, at least 64.
+func (r Result) BigFloat(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec).SetRat(r.v.Rat())
+}
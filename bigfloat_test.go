@@ -0,0 +1,86 @@
+package mathx
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// bigFloat parses s at prec bits
+func bigFloat(s string, prec uint) *big.Float {
+	f, _, err := big.ParseFloat(s, 10, prec, big.ToNearestEven)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func TestBigFloatArithmetic(t *testing.T) {
+	a := bigFloat("12345678901234567890.123456789", 200)
+	b := bigFloat("0.000000000000000000001", 200)
+
+	if got := BigFloatAdd(a, b).Text('f', 30); got != "12345678901234567890.123456789000000000001000000000" {
+		t.Errorf("BigFloatAdd() = %v", got)
+	}
+	if got := BigFloatSub(a, a).Sign(); got != 0 {
+		t.Errorf("BigFloatSub(a, a) = %v, want 0", got)
+	}
+	// float64 would lose everything past the 17th digit
+	if got := BigFloatMul(a, bigFloat("2", 200)).Text('f', 9); got != "24691357802469135780.246913578" {
+		t.Errorf("BigFloatMul() = %v", got)
+	}
+	q, err := BigFloatDiv(bigFloat("1", 200), bigFloat("3", 200))
+	if err != nil {
+		t.Fatalf("BigFloatDiv() error = %v", err)
+	}
+	if got := q.Text('f', 50); got != "0.33333333333333333333333333333333333333333333333333" {
+		t.Errorf("BigFloatDiv() = %v", got)
+	}
+	if q.Prec() != 200 {
+		t.Errorf("BigFloatDiv() precision = %d, want 200", q.Prec())
+	}
+
+	if got := BigFloatMul(bigFloat("1.5", 64), bigFloat("2", 300)).Prec(); got != 300 {
+		t.Errorf("BigFloatMul() precision = %d, want the larger operand precision 300", got)
+	}
+}
+
+func TestBigFloatDiv_errors(t *testing.T) {
+	if _, err := BigFloatDiv(big.NewFloat(1), big.NewFloat(0)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("BigFloatDiv() error = %v, want ErrDivisionByZero", err)
+	}
+	inf := new(big.Float).SetInf(false)
+	if _, err := BigFloatDiv(inf, inf); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("BigFloatDiv() error = %v, want ErrNotFinite", err)
+	}
+}
+
+func TestBigFloatCmp(t *testing.T) {
+	a := bigFloat("1.00000000000000000000000001", 200)
+	b := bigFloat("1", 200)
+	if BigFloatCmp(a, b) != 1 || BigFloatCmp(b, a) != -1 || BigFloatCmp(a, a) != 0 {
+		t.Error("BigFloatCmp() gives an inconsistent order")
+	}
+}
+
+func TestResultBigFloatConversions(t *testing.T) {
+	r := MustResultFromString("123456789.123456789123456789")
+	f := r.BigFloat(200)
+	back, err := NewResultFromBigFloat(f)
+	if err != nil {
+		t.Fatalf("NewResultFromBigFloat() error = %v", err)
+	}
+	if !back.Equal(r) {
+		t.Errorf("round trip = %v, want %v", back, r)
+	}
+
+	if got, _ := NewResultFromBigFloat(big.NewFloat(0.1)); got.String() != "0.1" {
+		t.Errorf("NewResultFromBigFloat(0.1) = %v, want 0.1", got)
+	}
+	if got := MustResultFromString("0.5").BigFloat(0).Text('g', -1); got != "0.5" {
+		t.Errorf("BigFloat(0) = %v, want 0.5", got)
+	}
+	if _, err := NewResultFromBigFloat(new(big.Float).SetInf(true)); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("NewResultFromBigFloat(-Inf) error = %v, want ErrNotFinite", err)
+	}
+}
@@ -0,0 +1,94 @@
+package mathx
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+	"golang.org/x/exp/constraints"
+)
+
+// The Big functions operate on base-10 integer strings of any size, such as
+// token amounts in wei, and return the result as a base-10 string.
+
+// BigAdd adds two integer strings
+func BigAdd(a, b string) (string, error) {
+	x, y, err := parseBigOperands(a, b)
+	if err != nil {
+		return "", err
+	}
+	return x.Add(x, y).String(), nil
+}
+
+// BigSub subtracts two integer strings
+func BigSub(a, b string) (string, error) {
+	x, y, err := parseBigOperands(a, b)
+	if err != nil {
+		return "", err
+	}
+	return x.Sub(x, y).String(), nil
+}
+
+// BigMul multiplies two integer strings
+func BigMul(a, b string) (string, error) {
+	x, y, err := parseBigOperands(a, b)
+	if err != nil {
+		return "", err
+	}
+	return x.Mul(x, y).String(), nil
+}
+
+// BigDiv divides two integer strings, truncating towards zero like Go's integer division.
+// It returns ErrDivisionByZero if b is zero.
+func BigDiv(a, b string) (string, error) {
+	x, y, err := parseBigOperands(a, b)
+	if err != nil {
+		return "", err
+	}
+	if y.Sign() == 0 {
+		return "", ErrDivisionByZero
+	}
+	return x.Quo(x, y).String(), nil
+}
+
+// parseBigOperands parses two base-10 integer strings
+func parseBigOperands(a, b string) (*big.Int, *big.Int, error) {
+	x, ok := new(big.Int).SetString(a, 10)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %q", ErrInvalidFormat, a)
+	}
+	y, ok := new(big.Int).SetString(b, 10)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %q", ErrInvalidFormat, b)
+	}
+	return x, y, nil
+}
+
+// SumBig returns the exact sum of a slice of integers as a big.Int, which never overflows
+func SumBig[T constraints.Integer](ns ...T) *big.Int {
+	var zero T
+	signed := ^zero < zero
+	sum, x := new(big.Int), new(big.Int)
+	for _, n := range ns {
+		if signed {
+			x.SetInt64(int64(n))
+		} else {
+			x.SetUint64(uint64(n))
+		}
+		sum.Add(sum, x)
+	}
+	return sum
+}
+
+// NewResultFromBigInt creates a new Result from a big.Int
+func NewResultFromBigInt(value *big.Int) Result {
+	return Result{v: decimal.NewFromBigInt(value, 0)}
+}
+
+// BigInt converts the result to a big.Int, or returns ErrNotInteger if it has a fractional part
+func (r Result) BigInt() (*big.Int, error) {
+	if !r.v.IsInteger() {
+		return nil, ErrNotInteger
+	}
+	return r.v.BigInt(), nil
+}
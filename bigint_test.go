@@ -0,0 +1,89 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigArithmetic(t *testing.T) {
+	const wei = "1000000000000000000000000" // 1e24, beyond int64
+
+	tests := []struct {
+		name     string
+		fn       func(a, b string) (string, error)
+		a, b     string
+		expected string
+	}{
+		{"add", BigAdd, wei, "1", "1000000000000000000000001"},
+		{"sub", BigSub, "1", wei, "-999999999999999999999999"},
+		{"mul", BigMul, wei, wei, "1000000000000000000000000000000000000000000000000"},
+		{"div", BigDiv, wei, "3", "333333333333333333333333"},
+		{"div truncates", BigDiv, "-7", "2", "-3"},
+		{"sign", BigAdd, "+5", "-8", "-3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("%s(%s, %s) = %v, want %v", tt.name, tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBigArithmetic_errors(t *testing.T) {
+	if _, err := BigDiv("1", "0"); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("BigDiv() error = %v, want ErrDivisionByZero", err)
+	}
+	for _, bad := range []string{"1.5", "", "1e3", "abc"} {
+		if _, err := BigAdd(bad, "1"); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("BigAdd(%q) error = %v, want ErrInvalidFormat", bad, err)
+		}
+		if _, err := BigMul("1", bad); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("BigMul(%q) error = %v, want ErrInvalidFormat", bad, err)
+		}
+	}
+}
+
+func TestResultBigInt(t *testing.T) {
+	n, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	r := NewResultFromBigInt(n)
+	if r.String() != "123456789012345678901234567890" {
+		t.Errorf("NewResultFromBigInt() = %v", r)
+	}
+
+	back, err := r.Mul(Two).BigInt()
+	if err != nil {
+		t.Fatalf("BigInt() error = %v", err)
+	}
+	if back.String() != "246913578024691357802469135780" {
+		t.Errorf("BigInt() = %v", back)
+	}
+	if got, err := MustResultFromString("1.2e3").BigInt(); err != nil || got.Int64() != 1200 {
+		t.Errorf("BigInt() = %v, %v, want 1200", got, err)
+	}
+	if _, err := MustResultFromString("1.5").BigInt(); !errors.Is(err, ErrNotInteger) {
+		t.Errorf("BigInt() error = %v, want ErrNotInteger", err)
+	}
+}
+
+func TestSumBig(t *testing.T) {
+	if got := SumBig[int64](math.MaxInt64, math.MaxInt64, 2).String(); got != "18446744073709551616" {
+		t.Errorf("SumBig() = %v, want 18446744073709551616", got)
+	}
+	if got := SumBig[int64](math.MinInt64, -1).String(); got != "-9223372036854775809" {
+		t.Errorf("SumBig() = %v, want -9223372036854775809", got)
+	}
+	if got := SumBig[uint64](math.MaxUint64, 1).String(); got != "18446744073709551616" {
+		t.Errorf("SumBig[uint64]() = %v, want 18446744073709551616", got)
+	}
+	if got := SumBig[int](); got.Sign() != 0 {
+		t.Errorf("SumBig() of nothing = %v, want 0", got)
+	}
+}
@@ -0,0 +1,41 @@
+package mathx
+
+import (
+	"slices"
+	"sort"
+)
+
+// Bucketize returns the index of the bucket that value falls into, given ascending
+// bucket edges: 0 if value < edges[0], i if edges[i-1] <= value < edges[i], and
+// len(edges) if value >= the last edge. NaN falls into the last bucket.
+func Bucketize(value float64, edges []float64) int {
+	return sort.Search(len(edges), func(i int) bool { return edges[i] > value })
+}
+
+// Grader maps values to labels by range, e.g. scores to "A"–"F"
+type Grader struct {
+	edges  []float64
+	labels []string
+}
+
+// NewGrader creates a Grader from ascending edges and one more label than edges;
+// labels[i] is used for bucket i as returned by Bucketize. For example edges
+// {60, 70, 80, 90} with labels {"F", "D", "C", "B", "A"} grade 85 as "B".
+// It returns ErrLengthMismatch if the counts don't fit and ErrDomain if the edges
+// are not strictly ascending.
+func NewGrader(edges []float64, labels []string) (Grader, error) {
+	if len(labels) != len(edges)+1 {
+		return Grader{}, ErrLengthMismatch
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i] > edges[i-1]) {
+			return Grader{}, ErrDomain
+		}
+	}
+	return Grader{edges: slices.Clone(edges), labels: slices.Clone(labels)}, nil
+}
+
+// Grade returns the label of the range that value falls into
+func (g Grader) Grade(value float64) string {
+	return g.labels[Bucketize(value, g.edges)]
+}
@@ -0,0 +1,68 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBucketize(t *testing.T) {
+	edges := []float64{0, 10, 20}
+	tests := []struct {
+		value    float64
+		expected int
+	}{
+		{-5, 0},
+		{0, 1},
+		{9.99, 1},
+		{10, 2},
+		{20, 3},
+		{1e9, 3},
+		{math.Inf(-1), 0},
+		{math.NaN(), 3},
+	}
+
+	for _, tt := range tests {
+		if got := Bucketize(tt.value, edges); got != tt.expected {
+			t.Errorf("Bucketize(%v) = %v, want %v", tt.value, got, tt.expected)
+		}
+	}
+	if got := Bucketize(5, nil); got != 0 {
+		t.Errorf("Bucketize() with no edges = %v, want 0", got)
+	}
+}
+
+func TestGrader(t *testing.T) {
+	g, err := NewGrader([]float64{60, 70, 80, 90}, []string{"F", "D", "C", "B", "A"})
+	if err != nil {
+		t.Fatalf("NewGrader() error = %v", err)
+	}
+	tests := []struct {
+		score    float64
+		expected string
+	}{
+		{0, "F"},
+		{59.9, "F"},
+		{60, "D"},
+		{85, "B"},
+		{90, "A"},
+		{100, "A"},
+	}
+	for _, tt := range tests {
+		if got := g.Grade(tt.score); got != tt.expected {
+			t.Errorf("Grade(%v) = %v, want %v", tt.score, got, tt.expected)
+		}
+	}
+
+	level, _ := NewGrader([]float64{0.33, 0.66}, []string{"low", "medium", "high"})
+	if got := level.Grade(0.5); got != "medium" {
+		t.Errorf("Grade(0.5) = %v, want medium", got)
+	}
+
+	if _, err := NewGrader([]float64{1, 2}, []string{"a", "b"}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("NewGrader() error = %v, want ErrLengthMismatch", err)
+	}
+	if _, err := NewGrader([]float64{2, 1}, []string{"a", "b", "c"}); !errors.Is(err, ErrDomain) {
+		t.Errorf("NewGrader() error = %v, want ErrDomain", err)
+	}
+}
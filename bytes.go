@@ -0,0 +1,98 @@
+package mathx
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// byteUnitPrefixes are the unit prefixes used by FormatBytes and ParseBytes in increasing order
+var byteUnitPrefixes = []string{"", "K", "M", "G", "T", "P", "E"}
+
+// FormatBytes formats a byte count in human readable form with up to two decimal places,
+// using binary units (KiB, MiB, ...; powers of 1024) or decimal units (KB, MB, ...; powers
+// of 1000), e.g. FormatBytes(1610612736, true) == "1.5 GiB"
+func FormatBytes(n int64, binary bool) string {
+	base := decimal.NewFromInt(1000)
+	if binary {
+		base = decimal.NewFromInt(1024)
+	}
+
+	value := decimal.NewFromInt(n)
+	abs := value.Abs()
+	unit := 0
+	for unit < len(byteUnitPrefixes)-1 && abs.GreaterThanOrEqual(base) {
+		abs = abs.Div(base)
+		unit++
+	}
+	rounded := abs.Round(2)
+	// rounding may carry into the next unit, e.g. 1023.999 KiB
+	if rounded.GreaterThanOrEqual(base) && unit < len(byteUnitPrefixes)-1 {
+		rounded = abs.Div(base).Round(2)
+		unit++
+	}
+	if value.IsNegative() {
+		rounded = rounded.Neg()
+	}
+
+	suffix := "B"
+	if unit > 0 {
+		suffix = byteUnitPrefixes[unit] + "B"
+		if binary {
+			suffix = byteUnitPrefixes[unit] + "iB"
+		}
+	}
+	return rounded.String() + " " + suffix
+}
+
+// ParseBytes parses a human readable byte size such as "2.5GB", "1 GiB", "512k" or "100"
+// into a number of bytes. Units are case-insensitive; "KB", "K" mean 1000 bytes and
+// "KiB" means 1024 bytes. Fractional byte counts are rounded to the nearest byte.
+func ParseBytes(s string) (int64, error) {
+	str := strings.TrimSpace(s)
+	i := len(str)
+	for i > 0 && (str[i-1] < '0' || str[i-1] > '9') && str[i-1] != '.' {
+		i--
+	}
+	number := strings.TrimSpace(str[:i])
+	unit := strings.ToUpper(strings.TrimSpace(str[i:]))
+
+	value, err := decimal.NewFromString(number)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+
+	multiplier, ok := byteMultiplier(unit)
+	if !ok {
+		return 0, fmt.Errorf("%w: unknown unit in %q", ErrInvalidFormat, s)
+	}
+	bytes := value.Mul(multiplier).Round(0)
+	if bytes.GreaterThan(decimal.NewFromInt(math.MaxInt64)) || bytes.LessThan(decimal.NewFromInt(math.MinInt64)) {
+		return 0, ErrOverflow
+	}
+	return bytes.IntPart(), nil
+}
+
+// byteMultiplier returns the number of bytes in an upper-case unit such as "", "B", "K", "KB" or "KIB"
+func byteMultiplier(unit string) (decimal.Decimal, bool) {
+	if unit == "" || unit == "B" {
+		return decimal.New(1, 0), true
+	}
+	base := decimal.NewFromInt(1000)
+	prefix := unit
+	switch {
+	case strings.HasSuffix(unit, "IB"):
+		base = decimal.NewFromInt(1024)
+		prefix = strings.TrimSuffix(unit, "IB")
+	case strings.HasSuffix(unit, "B"):
+		prefix = strings.TrimSuffix(unit, "B")
+	}
+	for exp, p := range byteUnitPrefixes[1:] {
+		if prefix == p {
+			return base.Pow(decimal.NewFromInt(int64(exp + 1))), true
+		}
+	}
+	return decimal.Decimal{}, false
+}
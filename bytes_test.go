@@ -0,0 +1,95 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int64
+		binary   bool
+		expected string
+	}{
+		{"bytes", 512, true, "512 B"},
+		{"zero", 0, false, "0 B"},
+		{"one KiB", 1024, true, "1 KiB"},
+		{"one KB", 1000, false, "1 KB"},
+		{"1.5 GiB", 1610612736, true, "1.5 GiB"},
+		{"1.5 GB", 1500000000, false, "1.5 GB"},
+		{"two decimals", 1234567, false, "1.23 MB"},
+		{"carry into next unit", 1048575, true, "1 MiB"},
+		{"negative", -2048, true, "-2 KiB"},
+		{"max int64", math.MaxInt64, true, "8 EiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatBytes(tt.n, tt.binary); got != tt.expected {
+				t.Errorf("FormatBytes() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int64
+	}{
+		{"plain number", "100", 100},
+		{"bytes", "10 B", 10},
+		{"decimal unit", "2.5GB", 2500000000},
+		{"binary unit", "1 GiB", 1073741824},
+		{"lower case", "512kb", 512000},
+		{"short unit", "3M", 3000000},
+		{"fractional binary", "1.5 KiB", 1536},
+		{"rounded", "1.5", 2},
+		{"spaces", "  4 TiB ", 4398046511104},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBytes(tt.input)
+			if err != nil {
+				t.Fatalf("ParseBytes() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("ParseBytes() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseBytes_errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"empty", "", ErrInvalidFormat},
+		{"no number", "GB", ErrInvalidFormat},
+		{"unknown unit", "5 XB", ErrInvalidFormat},
+		{"overflow", "9 EiB", ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseBytes(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("ParseBytes() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestFormatBytes_roundTrip(t *testing.T) {
+	for _, n := range []int64{0, 1, 1536, 1610612736, 5 << 40} {
+		got, err := ParseBytes(FormatBytes(n, true))
+		if err != nil || got != n {
+			t.Errorf("ParseBytes(FormatBytes(%d)) = %v, %v", n, got, err)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package mathx
+
+import (
+	"math"
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+// changeDPLimit bounds the part of an amount, in the smallest common unit, that
+// MakeChange settles with the smaller denominations when it cannot prove a smaller
+// bound; changeDPMaxLimit bounds how far it widens that part to find exact change
+const (
+	changeDPLimit    = 1 << 20
+	changeDPMaxLimit = 1 << 22
+)
+
+// MakeChange breaks amount into the fewest notes and coins from denominations and
+// returns how many of each are used, keyed by the denomination's Key (e.g. "0.05").
+// The result is minimal at any amount for ordinary currencies, where the smaller
+// denominations never need to cover more than a million of the smallest unit. For
+// unusual denominations such as {1000000, 999999} the result is exact but not always
+// minimal, and ErrNoExactChange is also returned when the smaller denominations would
+// have to cover more than about four million units.
+// It returns ErrDomain for a negative amount or a non-positive denomination,
+// ErrNoExactChange if the amount cannot be made exactly and ErrOverflow if it is too
+// large to count in the smallest unit.
+func MakeChange(amount Result, denominations []Result) (map[string]int, error) {
+	if amount.v.Sign() < 0 {
+		return nil, ErrDomain
+	}
+	ds := make([]decimal.Decimal, 0, len(denominations))
+	for _, d := range denominations {
+		if d.v.Sign() <= 0 {
+			return nil, ErrDomain
+		}
+		ds = append(ds, d.v)
+	}
+	SortDecimals(ds)
+	ds = slices.CompactFunc(ds, decimal.Decimal.Equal)
+	slices.Reverse(ds)
+
+	// count everything in the smallest unit any of the values needs
+	scale := max(-amount.v.Exponent(), 0)
+	for _, d := range ds {
+		scale = max(scale, -d.Exponent())
+	}
+	target, err := checkedInt(amount.v.Shift(scale), math.MinInt64, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	units := make([]int64, len(ds))
+	for i, d := range ds {
+		if units[i], err = checkedInt(d.Shift(scale), math.MinInt64, math.MaxInt64); err != nil {
+			return nil, err
+		}
+	}
+
+	counts, ok := makeChangeUnits(target, units)
+	if !ok {
+		return nil, ErrNoExactChange
+	}
+	change := make(map[string]int)
+	for i, n := range counts {
+		if n > 0 {
+			change[ds[i].String()] = int(n)
+		}
+	}
+	return change, nil
+}
+
+// makeChangeUnits returns the count of each denomination (sorted descending) that
+// sums to target with the fewest pieces, reporting false if that is impossible
+func makeChangeUnits(target int64, units []int64) ([]int64, bool) {
+	counts := make([]int64, len(units))
+	if target == 0 {
+		return counts, true
+	}
+	if len(units) == 0 {
+		return nil, false
+	}
+
+	// every sum of denominations is a multiple of their gcd
+	g := units[0]
+	for _, u := range units[1:] {
+		g = gcd(g, u)
+	}
+	if target%g != 0 {
+		return nil, false
+	}
+	target /= g
+	scaled := make([]int64, len(units))
+	for i, u := range units {
+		scaled[i] = u / g
+	}
+
+	// the largest denomination covers all but rem; widen rem one largest piece at a
+	// time until the smaller denominations can make it
+	window := changeWindow(scaled)
+	minimal := window <= changeDPLimit
+	reach := min(target, window, changeDPLimit)
+	k := min((target-reach+scaled[0]-1)/scaled[0], target/scaled[0])
+	rem := target - k*scaled[0]
+
+	// best[x] is the fewest pieces summing to x, or -1; last[x] the denomination used last
+	best := make([]int32, 1, rem+1)
+	last := make([]int32, 1, rem+1)
+	for {
+		for x := int64(len(best)); x <= rem; x++ {
+			b, l := int32(-1), int32(0)
+			for i, u := range scaled {
+				if u <= x && best[x-u] >= 0 && (b < 0 || best[x-u]+1 < b) {
+					b, l = best[x-u]+1, int32(i)
+				}
+			}
+			best = append(best, b)
+			last = append(last, l)
+		}
+		if best[rem] >= 0 {
+			break
+		}
+		if minimal || k == 0 || rem+scaled[0] > changeDPMaxLimit {
+			return nil, false
+		}
+		k--
+		rem += scaled[0]
+	}
+	counts[0] = k
+	for x := rem; x > 0; x -= scaled[last[x]] {
+		counts[last[x]]++
+	}
+	return counts, true
+}
+
+// changeWindow bounds the sum of the pieces other than the largest in a minimal
+// solution, for denominations sorted descending: a denomination u is used fewer than
+// lcm(u, largest)/u times, or those pieces could be swapped for fewer of the largest.
+// It saturates at math.MaxInt64.
+func changeWindow(units []int64) int64 {
+	var window int64
+	for _, u := range units[1:] {
+		m := units[0] / gcd(units[0], u)
+		if m > math.MaxInt64/u {
+			return math.MaxInt64
+		}
+		part := m*u - u
+		if window > math.MaxInt64-part {
+			return math.MaxInt64
+		}
+		window += part
+	}
+	return window
+}
+
+// gcd returns the greatest common divisor of two positive integers
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,86 @@
+package mathx
+
+import (
+	"errors"
+	"maps"
+	"runtime"
+	"testing"
+)
+
+// results parses decimal strings into Results
+func results(ss ...string) []Result {
+	rs := make([]Result, len(ss))
+	for i, s := range ss {
+		rs[i] = MustResultFromString(s)
+	}
+	return rs
+}
+
+func TestMakeChange(t *testing.T) {
+	euro := results("0.01", "0.02", "0.05", "0.10", "0.20", "0.50", "1", "2", "5", "10", "20", "50")
+
+	tests := []struct {
+		name          string
+		amount        string
+		denominations []Result
+		expected      map[string]int
+	}{
+		{"euro", "38.77", euro, map[string]int{"20": 1, "10": 1, "5": 1, "2": 1, "1": 1, "0.5": 1, "0.2": 1, "0.05": 1, "0.02": 1}},
+		{"zero", "0", euro, map[string]int{}},
+		{"non-greedy", "6", results("1", "3", "4"), map[string]int{"3": 2}},
+		{"greedy fails", "6", results("5", "2"), map[string]int{"2": 3}},
+		{"duplicates", "0.3", results("0.1", "0.10", "0.2"), map[string]int{"0.2": 1, "0.1": 1}},
+		{"large amount", "1000000.05", results("0.05", "100"), map[string]int{"100": 10000, "0.05": 1}},
+		{"large euro amount", "123456789.99", euro, map[string]int{"50": 2469135, "20": 1, "10": 1, "5": 1, "2": 2, "0.5": 1, "0.2": 2, "0.05": 1, "0.02": 2}},
+		{"wide denominations", "2999997", results("1000000", "999999"), map[string]int{"999999": 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MakeChange(MustResultFromString(tt.amount), tt.denominations)
+			if err != nil {
+				t.Fatalf("MakeChange() error = %v", err)
+			}
+			if !maps.Equal(got, tt.expected) {
+				t.Errorf("MakeChange() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMakeChange_memory(t *testing.T) {
+	euro := results("0.01", "0.02", "0.05", "0.10", "0.20", "0.50", "1", "2", "5", "10", "20", "50")
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	if _, err := MakeChange(MustResultFromString("987654.32"), euro); err != nil {
+		t.Fatalf("MakeChange() error = %v", err)
+	}
+	runtime.ReadMemStats(&after)
+	if n := after.TotalAlloc - before.TotalAlloc; n > 1<<20 {
+		t.Errorf("MakeChange() allocated %d bytes for an ordinary amount", n)
+	}
+}
+
+func TestMakeChange_errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        string
+		denominations []Result
+		err           error
+	}{
+		{"finer than smallest coin", "0.03", results("0.05", "0.10"), ErrNoExactChange},
+		{"unreachable", "3", results("2", "4"), ErrNoExactChange},
+		{"no denominations", "1", nil, ErrNoExactChange},
+		{"negative amount", "-1", results("1"), ErrDomain},
+		{"zero denomination", "1", results("0", "1"), ErrDomain},
+		{"too many units", "1e30", results("1"), ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := MakeChange(MustResultFromString(tt.amount), tt.denominations); !errors.Is(err, tt.err) {
+				t.Errorf("MakeChange() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package mathx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Luhn reports whether s, e.g. a card number, passes the Luhn (mod 10) check.
+// Spaces and hyphens are ignored; any other non-digit, or fewer than two digits,
+// fails the check.
+func Luhn(s string) bool {
+	digits, ok := checkDigits(s)
+	if !ok || len(digits) < 2 {
+		return false
+	}
+	return luhnSum(digits, false)%10 == 0
+}
+
+// LuhnGenerate returns s with its Luhn check digit appended, e.g. "7992739871" becomes
+// "79927398713". Spaces and hyphens are dropped. It returns ErrInvalidFormat if s
+// contains other non-digits or no digits at all.
+func LuhnGenerate(s string) (string, error) {
+	digits, ok := checkDigits(s)
+	if !ok || len(digits) == 0 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	check := (10 - luhnSum(digits, true)%10) % 10
+	return string(append(digits, byte('0'+check))), nil
+}
+
+// luhnSum returns the Luhn sum of digits. Counting from the right, every second digit
+// is doubled; with payload set the doubling starts at the rightmost digit, as it does
+// once the check digit is appended.
+func luhnSum(digits []byte, payload bool) int {
+	sum := 0
+	double := payload
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum
+}
+
+// checkDigits returns the digits of s without spaces and hyphens, and false if s
+// contains any other character
+func checkDigits(s string) ([]byte, bool) {
+	digits := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits = append(digits, c)
+		case c == ' ' || c == '-':
+		default:
+			return nil, false
+		}
+	}
+	return digits, true
+}
+
+// Mod97 returns the ISO 7064 MOD 97-10 remainder of s, where letters count as two-digit
+// numbers (A = 10, ..., Z = 35) as in IBANs. Letters are case-insensitive and spaces
+// are ignored. It returns ErrInvalidFormat for other characters or an empty string.
+func Mod97(s string) (int, error) {
+	rem, n := 0, 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			rem = (rem*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z':
+			rem = (rem*100 + int((c|0x20)-'a') + 10) % 97
+		case c == ' ':
+			continue
+		default:
+			return 0, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+		}
+		n++
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return rem, nil
+}
+
+// Mod97CheckDigits returns the two ISO 7064 MOD 97-10 check digits for s, chosen so
+// that Mod97 of s followed by the digits is 1
+func Mod97CheckDigits(s string) (string, error) {
+	rem, err := Mod97(s + "00")
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return fmt.Sprintf("%02d", 98-rem), nil
+}
+
+// ValidIBAN reports whether iban has a valid structure and check digits: a two-letter
+// country code, two check digits and up to 30 alphanumerics, which with the first four
+// characters moved to the end leave a Mod97 remainder of 1. Spaces are ignored.
+func ValidIBAN(iban string) bool {
+	s := strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+	if len(s) < 5 || len(s) > 34 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		letter := c >= 'A' && c <= 'Z'
+		digit := c >= '0' && c <= '9'
+		if (i < 2 && !letter) || (i >= 2 && i < 4 && !digit) || !(letter || digit) {
+			return false
+		}
+	}
+	rem, err := Mod97(s[4:] + s[:4])
+	return err == nil && rem == 1
+}
+
+// dammTable is the totally anti-symmetric quasigroup of order 10 used by the Damm algorithm
+var dammTable = [10][10]byte{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+// Damm reports whether s passes the Damm check, which detects all single-digit errors
+// and all adjacent transpositions. Spaces and hyphens are ignored; any other non-digit,
+// or fewer than two digits, fails the check.
+func Damm(s string) bool {
+	digits, ok := checkDigits(s)
+	if !ok || len(digits) < 2 {
+		return false
+	}
+	return dammInterim(digits) == 0
+}
+
+// DammGenerate returns s with its Damm check digit appended, e.g. "572" becomes "5724".
+// Spaces and hyphens are dropped. It returns ErrInvalidFormat if s contains other
+// non-digits or no digits at all.
+func DammGenerate(s string) (string, error) {
+	digits, ok := checkDigits(s)
+	if !ok || len(digits) == 0 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return string(append(digits, '0'+dammInterim(digits))), nil
+}
+
+// dammInterim runs digits through the Damm quasigroup and returns the final interim digit
+func dammInterim(digits []byte) byte {
+	var interim byte
+	for _, c := range digits {
+		interim = dammTable[interim][c-'0']
+	}
+	return interim
+}
+
+// verhoeffMul is the multiplication table of the dihedral group D5
+var verhoeffMul = [10][10]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+// verhoeffPerm holds the position-dependent permutations of the Verhoeff algorithm
+var verhoeffPerm = [8][10]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+// verhoeffInv holds the inverses in D5
+var verhoeffInv = [10]byte{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+
+// Verhoeff reports whether s passes the Verhoeff check, which detects all single-digit
+// errors and all adjacent transpositions. Spaces and hyphens are ignored; any other
+// non-digit, or fewer than two digits, fails the check.
+func Verhoeff(s string) bool {
+	digits, ok := checkDigits(s)
+	if !ok || len(digits) < 2 {
+		return false
+	}
+	return verhoeffSum(digits, 0) == 0
+}
+
+// VerhoeffGenerate returns s with its Verhoeff check digit appended, e.g. "236" becomes
+// "2363". Spaces and hyphens are dropped. It returns ErrInvalidFormat if s contains
+// other non-digits or no digits at all.
+func VerhoeffGenerate(s string) (string, error) {
+	digits, ok := checkDigits(s)
+	if !ok || len(digits) == 0 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return string(append(digits, '0'+verhoeffInv[verhoeffSum(digits, 1)])), nil
+}
+
+// verhoeffSum combines digits from the right, permuting the digit at position i by
+// verhoeffPerm[(i+offset) % 8]. The offset is 1 when the check digit is still missing.
+func verhoeffSum(digits []byte, offset int) byte {
+	var c byte
+	for i := 0; i < len(digits); i++ {
+		d := digits[len(digits)-1-i] - '0'
+		c = verhoeffMul[c][verhoeffPerm[(i+offset)%8][d]]
+	}
+	return c
+}
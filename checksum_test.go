@@ -0,0 +1,167 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLuhn(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected bool
+	}{
+		{"79927398713", true},
+		{"79927398710", false},
+		{"4111 1111 1111 1111", true},
+		{"4111-1111-1111-1112", false},
+		{"0", false},
+		{"00", true},
+		{"4111x1111", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := Luhn(tt.s); got != tt.expected {
+			t.Errorf("Luhn(%q) = %v, want %v", tt.s, got, tt.expected)
+		}
+	}
+}
+
+func TestLuhnGenerate(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected string
+	}{
+		{"7992739871", "79927398713"},
+		{"4111 1111 1111 111", "4111111111111111"},
+		{"0", "00"},
+		{"1", "18"},
+	}
+
+	for _, tt := range tests {
+		got, err := LuhnGenerate(tt.s)
+		if err != nil || got != tt.expected {
+			t.Errorf("LuhnGenerate(%q) = %q, %v, want %q, nil", tt.s, got, err, tt.expected)
+		}
+		if !Luhn(got) {
+			t.Errorf("Luhn(LuhnGenerate(%q)) = false, want true", tt.s)
+		}
+	}
+	for _, s := range []string{"", "12a"} {
+		if _, err := LuhnGenerate(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("LuhnGenerate(%q) error = %v, want ErrInvalidFormat", s, err)
+		}
+	}
+}
+
+func TestMod97(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected int
+	}{
+		{"97", 0},
+		{"98", 1},
+		{"3214282912345698765432161182", 1},
+		{"B", 11},
+		{"b", 11},
+		{"1 00", 3},
+	}
+
+	for _, tt := range tests {
+		got, err := Mod97(tt.s)
+		if err != nil || got != tt.expected {
+			t.Errorf("Mod97(%q) = %v, %v, want %v, nil", tt.s, got, err, tt.expected)
+		}
+	}
+	for _, s := range []string{"", " ", "12-3"} {
+		if _, err := Mod97(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Mod97(%q) error = %v, want ErrInvalidFormat", s, err)
+		}
+	}
+}
+
+func TestMod97CheckDigits(t *testing.T) {
+	// the check digits of GB82 WEST 1234 5698 7654 32 are computed over the BBAN and "GB"
+	got, err := Mod97CheckDigits("WEST12345698765432GB")
+	if err != nil || got != "82" {
+		t.Errorf("Mod97CheckDigits() = %q, %v, want 82, nil", got, err)
+	}
+	if _, err := Mod97CheckDigits("#"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Mod97CheckDigits() error = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestValidIBAN(t *testing.T) {
+	tests := []struct {
+		iban     string
+		expected bool
+	}{
+		{"GB82 WEST 1234 5698 7654 32", true},
+		{"gb82west12345698765432", true},
+		{"DE89 3704 0044 0532 0130 00", true},
+		{"GB83 WEST 1234 5698 7654 32", false},
+		{"1B82WEST12345698765432", false},
+		{"GBX2WEST12345698765432", false},
+		{"GB82-WEST-1234", false},
+		{"GB82", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidIBAN(tt.iban); got != tt.expected {
+			t.Errorf("ValidIBAN(%q) = %v, want %v", tt.iban, got, tt.expected)
+		}
+	}
+}
+
+func TestDammVerhoeff(t *testing.T) {
+	tests := []struct {
+		name     string
+		valid    func(string) bool
+		generate func(string) (string, error)
+		payload  string
+		expected string
+	}{
+		{"Damm", Damm, DammGenerate, "572", "5724"},
+		{"Damm zero", Damm, DammGenerate, "0", "00"},
+		{"Damm spaced", Damm, DammGenerate, "12 34", "12340"},
+		{"Verhoeff", Verhoeff, VerhoeffGenerate, "236", "2363"},
+		{"Verhoeff long", Verhoeff, VerhoeffGenerate, "12345", "123451"},
+		{"Verhoeff zero", Verhoeff, VerhoeffGenerate, "0", "04"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.generate(tt.payload)
+			if err != nil || got != tt.expected {
+				t.Fatalf("generate(%q) = %q, %v, want %q, nil", tt.payload, got, err, tt.expected)
+			}
+			if !tt.valid(got) {
+				t.Errorf("valid(%q) = false, want true", got)
+			}
+			// every single-digit error and adjacent transposition must be detected
+			for i := 0; i < len(got); i++ {
+				for d := byte('0'); d <= '9'; d++ {
+					if d == got[i] {
+						continue
+					}
+					mutated := got[:i] + string(d) + got[i+1:]
+					if tt.valid(mutated) {
+						t.Errorf("valid(%q) = true for a single-digit error", mutated)
+					}
+				}
+				if i+1 < len(got) && got[i] != got[i+1] {
+					swapped := got[:i] + string(got[i+1]) + string(got[i]) + got[i+2:]
+					if tt.valid(swapped) {
+						t.Errorf("valid(%q) = true for a transposition", swapped)
+					}
+				}
+			}
+			if _, err := tt.generate("12a"); !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("generate(\"12a\") error = %v, want ErrInvalidFormat", err)
+			}
+			if tt.valid("5") || tt.valid("57x4") {
+				t.Error("valid() accepted a short or malformed input")
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package mathx
+
+import "github.com/shopspring/decimal"
+
+// Commonly used decimal constants, provided for convenience. The package itself uses
+// unexported copies, so reassigning one of these does not affect any mathx function.
+var (
+	// Zero is the decimal value 0
+	Zero = decimal.Zero
+	// Half is the decimal value 0.5
+	Half = decimal.New(5, -1)
+	// One is the decimal value 1
+	One = decimal.New(1, 0)
+	// Two is the decimal value 2
+	Two = decimal.New(2, 0)
+	// Ten is the decimal value 10
+	Ten = decimal.New(1, 1)
+	// Hundred is the decimal value 100
+	Hundred = decimal.New(1, 2)
+	// Thousand is the decimal value 1000
+	Thousand = decimal.New(1, 3)
+)
+
+// Decimal constants used internally, out of reach of callers
+var (
+	decimalHalf    = decimal.New(5, -1)
+	decimalOne     = decimal.New(1, 0)
+	decimalTwo     = decimal.New(2, 0)
+	decimalHundred = decimal.New(1, 2)
+)
+
+// pow10Range is the largest exponent magnitude kept in pow10Table
+const pow10Range = 32
+
+// pow10Table caches the decimal powers of ten from 10^-pow10Range to 10^pow10Range
+var pow10Table = func() [2*pow10Range + 1]decimal.Decimal {
+	var table [2*pow10Range + 1]decimal.Decimal
+	for i := range table {
+		table[i] = decimal.New(1, int32(i-pow10Range))
+	}
+	return table
+}()
+
+// pow10Decimal returns 10^n as a decimal, using the cached table when possible
+func pow10Decimal(n int32) decimal.Decimal {
+	if n >= -pow10Range && n <= pow10Range {
+		return pow10Table[n+pow10Range]
+	}
+	return decimal.New(1, n)
+}
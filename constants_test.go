@@ -0,0 +1,55 @@
+package mathx
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    decimal.Decimal
+		expected string
+	}{
+		{"Zero", Zero, "0"},
+		{"Half", Half, "0.5"},
+		{"One", One, "1"},
+		{"Two", Two, "2"},
+		{"Ten", Ten, "10"},
+		{"Hundred", Hundred, "100"},
+		{"Thousand", Thousand, "1000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.String(); got != tt.expected {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConstants_reassignment(t *testing.T) {
+	saved := [...]decimal.Decimal{Half, One, Two, Hundred}
+	defer func() { Half, One, Two, Hundred = saved[0], saved[1], saved[2], saved[3] }()
+	Half, One, Two, Hundred = decimal.Zero, decimal.New(7, 0), decimal.New(3, 0), decimal.New(1, 0)
+
+	if got := RoundToMultiple(2.5, 1, RoundHalfEven); got.String() != "2" {
+		t.Errorf("RoundHalfEven after reassigning Two = %v, want 2", got)
+	}
+	if got, err := AnnualizeRate(0.1, 2, true); err != nil || got.String() != "0.21" {
+		t.Errorf("AnnualizeRate() after reassigning One = %v, %v, want 0.21", got, err)
+	}
+	if got := StandardDeviationSafe(decimals("1", "3")...).Round(10); got.String() != "1.4142135624" {
+		t.Errorf("StandardDeviationSafe() after reassigning Half = %v", got)
+	}
+}
+
+func TestPow10Decimal(t *testing.T) {
+	for _, n := range []int32{-40, -32, -2, 0, 3, 32, 40} {
+		if got, want := pow10Decimal(n), decimal.New(1, n); !got.Equal(want) {
+			t.Errorf("pow10Decimal(%d) = %v, want %v", n, got, want)
+		}
+	}
+}
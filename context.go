@@ -0,0 +1,106 @@
+package mathx
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrorPolicy selects how a Context reacts to a division by zero
+type ErrorPolicy int
+
+const (
+	// PanicOnError panics, like Div
+	PanicOnError ErrorPolicy = iota
+	// ZeroOnError returns zero
+	ZeroOnError
+)
+
+// Context carries a numeric policy: every Result it produces is rounded to Precision
+// decimal places using Rounding, and Policy decides what Div does with a zero divisor.
+// Contexts are plain values, so libraries can use different policies side by side
+// without touching the package defaults. The zero value rounds to integers half up
+// and panics on division by zero.
+type Context struct {
+	Precision int32
+	Rounding  RoundingMode
+	Policy    ErrorPolicy
+}
+
+// NewContext returns a context with the given precision and rounding mode that panics
+// on division by zero
+func NewContext(precision int32, rounding RoundingMode) Context {
+	return Context{Precision: precision, Rounding: rounding}
+}
+
+// DefaultContext returns a context using the package defaults set by SetDefaults
+func DefaultContext() Context {
+	d := loadDefaults()
+	return Context{Precision: d.divisionPrecision, Rounding: d.rounding}
+}
+
+// New returns value rounded to the context's precision
+func (c Context) New(value float64) Result {
+	return c.round(decimal.NewFromFloat(value))
+}
+
+// NewFromDecimal returns value rounded to the context's precision
+func (c Context) NewFromDecimal(value decimal.Decimal) Result {
+	return c.round(value)
+}
+
+// NewFromString parses value and rounds it to the context's precision
+func (c Context) NewFromString(value string) (Result, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, value)
+	}
+	return c.round(d), nil
+}
+
+// Add returns a + b rounded to the context's precision
+func (c Context) Add(a, b Result) Result {
+	return c.round(a.v.Add(b.v))
+}
+
+// Sub returns a - b rounded to the context's precision
+func (c Context) Sub(a, b Result) Result {
+	return c.round(a.v.Sub(b.v))
+}
+
+// Mul returns a * b rounded to the context's precision
+func (c Context) Mul(a, b Result) Result {
+	return c.round(a.v.Mul(b.v))
+}
+
+// Div returns a / b rounded to the context's precision. A zero divisor is handled
+// according to the context's Policy.
+func (c Context) Div(a, b Result) Result {
+	r, err := c.DivE(a, b)
+	if err != nil {
+		if c.Policy == ZeroOnError {
+			return Result{}
+		}
+		panic(err)
+	}
+	return r
+}
+
+// DivE returns a / b rounded to the context's precision, or ErrDivisionByZero
+// regardless of the context's Policy
+func (c Context) DivE(a, b Result) (Result, error) {
+	if b.v.IsZero() {
+		return Result{}, ErrDivisionByZero
+	}
+	return Result{v: divRoundWith(a.v, b.v, c.Precision, c.Rounding)}, nil
+}
+
+// Round rounds r to the context's precision using its rounding mode
+func (c Context) Round(r Result) Result {
+	return c.round(r.v)
+}
+
+// round rounds d to the context's precision using its rounding mode
+func (c Context) round(d decimal.Decimal) Result {
+	return Result{v: roundDecimal(d, c.Precision, c.Rounding)}
+}
@@ -0,0 +1,71 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestContext(t *testing.T) {
+	money := NewContext(2, RoundHalfEven)
+	rates := NewContext(6, RoundDown)
+
+	tests := []struct {
+		name     string
+		got      Result
+		expected string
+	}{
+		{"New rounds", money.New(2.345), "2.34"},
+		{"NewFromDecimal rounds", rates.NewFromDecimal(decimal.RequireFromString("0.1234567")), "0.123456"},
+		{"Add", money.Add(NewResult(0.125), NewResult(1)), "1.12"},
+		{"Sub", money.Sub(NewResult(1), NewResult(0.005)), "1"},
+		{"Mul", money.Mul(NewResult(19.99), NewResult(0.075)), "1.5"},
+		{"Div", money.Div(NewResult(10), NewResult(3)), "3.33"},
+		{"Div tie", money.Div(NewResult(1), NewResult(8)), "0.12"},
+		{"Div other policy", rates.Div(NewResult(2), NewResult(3)), "0.666666"},
+		{"Round", rates.Round(MustResultFromString("-1.23456789")), "-1.234567"},
+		{"zero value", Context{}.Div(NewResult(5), NewResult(2)), "3"},
+		{"zero policy", Context{Precision: 2, Policy: ZeroOnError}.Div(NewResult(1), Result{}), "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.expected {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestContext_NewFromString(t *testing.T) {
+	c := NewContext(1, RoundUp)
+	got, err := c.NewFromString("1.21")
+	if err != nil || got.String() != "1.3" {
+		t.Errorf("NewFromString() = %v, %v, want 1.3, nil", got, err)
+	}
+	if _, err := c.NewFromString("abc"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("NewFromString(\"abc\") error = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestContext_DivByZero(t *testing.T) {
+	c := NewContext(2, RoundHalfUp)
+	if _, err := c.DivE(NewResult(1), Result{}); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("DivE() error = %v, want ErrDivisionByZero", err)
+	}
+	defer func() {
+		if r := recover(); r != ErrDivisionByZero {
+			t.Errorf("Div() panic = %v, want ErrDivisionByZero", r)
+		}
+	}()
+	c.Div(NewResult(1), Result{})
+}
+
+func TestDefaultContext(t *testing.T) {
+	t.Cleanup(ResetDefaults)
+	SetDefaults(3, RoundFloor, 1e-9)
+	if got := DefaultContext().Div(NewResult(-1), NewResult(3)).String(); got != "-0.334" {
+		t.Errorf("DefaultContext().Div() = %v, want -0.334", got)
+	}
+}
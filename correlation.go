@@ -0,0 +1,89 @@
+package mathx
+
+import "math"
+
+// CovarianceMatrix returns the sample covariance (n-1) matrix of the given series,
+// where element [i][j] is the covariance of series i and j. All series must have
+// the same length of at least two observations.
+func CovarianceMatrix(series ...[]float64) ([][]float64, error) {
+	if err := validateSeries(series); err != nil {
+		return nil, err
+	}
+	k := len(series)
+	n := len(series[0])
+
+	centered := make([][]float64, k)
+	for i, s := range series {
+		mean := Sum(s...) / float64(n)
+		centered[i] = make([]float64, n)
+		for t, v := range s {
+			centered[i][t] = v - mean
+		}
+	}
+
+	cov := make([][]float64, k)
+	for i := range cov {
+		cov[i] = make([]float64, k)
+	}
+	for i := 0; i < k; i++ {
+		for j := i; j < k; j++ {
+			var sum float64
+			for t := 0; t < n; t++ {
+				sum += centered[i][t] * centered[j][t]
+			}
+			cov[i][j] = sum / float64(n-1)
+			cov[j][i] = cov[i][j]
+		}
+	}
+	return cov, nil
+}
+
+// CorrelationMatrix returns the Pearson correlation matrix of the given series.
+// Correlations involving a constant series are NaN. All series must have the same
+// length of at least two observations.
+func CorrelationMatrix(series ...[]float64) ([][]float64, error) {
+	cov, err := CovarianceMatrix(series...)
+	if err != nil {
+		return nil, err
+	}
+	k := len(cov)
+	std := make([]float64, k)
+	for i := range std {
+		std[i] = math.Sqrt(cov[i][i])
+	}
+
+	corr := make([][]float64, k)
+	for i := range corr {
+		corr[i] = make([]float64, k)
+		for j := range corr[i] {
+			if std[i] == 0 || std[j] == 0 {
+				corr[i][j] = math.NaN()
+				continue
+			}
+			if i == j {
+				corr[i][j] = 1
+				continue
+			}
+			corr[i][j] = Clamp(cov[i][j]/(std[i]*std[j]), -1, 1)
+		}
+	}
+	return corr, nil
+}
+
+// validateSeries checks that there is at least one series and all series have
+// the same length of at least two observations
+func validateSeries(series [][]float64) error {
+	if len(series) == 0 {
+		return ErrEmptyInput
+	}
+	n := len(series[0])
+	for _, s := range series[1:] {
+		if len(s) != n {
+			return ErrLengthMismatch
+		}
+	}
+	if n < 2 {
+		return ErrInsufficientData
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCovarianceMatrix(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{2, 4, 6, 8, 10}
+	z := []float64{5, 4, 3, 2, 1}
+
+	cov, err := CovarianceMatrix(x, y, z)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := [][]float64{
+		{2.5, 5, -2.5},
+		{5, 10, -5},
+		{-2.5, -5, 2.5},
+	}
+	for i := range expected {
+		if !floatsEqual(cov[i], expected[i], 1e-10) {
+			t.Errorf("CovarianceMatrix()[%d] = %v, want %v", i, cov[i], expected[i])
+		}
+	}
+}
+
+func TestCorrelationMatrix(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{2, 4, 6, 8, 10}
+	z := []float64{5, 4, 3, 2, 1}
+	w := []float64{1, 3, 2, 5, 4}
+
+	corr, err := CorrelationMatrix(x, y, z, w)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := [][]float64{
+		{1, 1, -1, 0.8},
+		{1, 1, -1, 0.8},
+		{-1, -1, 1, -0.8},
+		{0.8, 0.8, -0.8, 1},
+	}
+	for i := range expected {
+		if !floatsEqual(corr[i], expected[i], 1e-10) {
+			t.Errorf("CorrelationMatrix()[%d] = %v, want %v", i, corr[i], expected[i])
+		}
+	}
+
+	corr, err = CorrelationMatrix(x, []float64{3, 3, 3, 3, 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !math.IsNaN(corr[0][1]) || !math.IsNaN(corr[1][1]) || corr[0][0] != 1 {
+		t.Errorf("CorrelationMatrix() with constant series = %v", corr)
+	}
+}
+
+func TestCorrelationMatrix_errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		series [][]float64
+		err    error
+	}{
+		{"no series", nil, ErrEmptyInput},
+		{"length mismatch", [][]float64{{1, 2, 3}, {1, 2}}, ErrLengthMismatch},
+		{"single observation", [][]float64{{1}, {2}}, ErrInsufficientData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := CorrelationMatrix(tt.series...); !errors.Is(err, tt.err) {
+				t.Errorf("CorrelationMatrix() error = %v, want %v", err, tt.err)
+			}
+			if _, err := CovarianceMatrix(tt.series...); !errors.Is(err, tt.err) {
+				t.Errorf("CovarianceMatrix() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
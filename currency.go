@@ -0,0 +1,96 @@
+package mathx
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// Currency describes how amounts in a currency are rounded
+type Currency struct {
+	Code          string          // ISO 4217 code, e.g. "USD"
+	MinorUnits    int32           // decimal places of the minor unit, e.g. 2 for cents
+	CashIncrement decimal.Decimal // smallest cash amount, e.g. 0.05 for CHF; zero means one minor unit
+}
+
+var (
+	currencyMu sync.RWMutex
+	currencies = map[string]Currency{}
+)
+
+func init() {
+	for code, minor := range map[string]int32{
+		"USD": 2, "EUR": 2, "GBP": 2, "CNY": 2, "INR": 2, "CHF": 2, "CAD": 2, "AUD": 2,
+		"NZD": 2, "SEK": 2, "NOK": 2, "DKK": 2, "HKD": 2, "SGD": 2, "TWD": 2, "BRL": 2,
+		"MXN": 2, "RUB": 2, "ZAR": 2, "TRY": 2, "PLN": 2, "CZK": 2, "HUF": 2, "ILS": 2,
+		"THB": 2, "IDR": 2, "MYR": 2, "PHP": 2, "AED": 2, "SAR": 2, "EGP": 2, "ARS": 2,
+		"JPY": 0, "KRW": 0, "VND": 0, "CLP": 0, "ISK": 0, "PYG": 0, "UGX": 0, "XAF": 0, "XOF": 0,
+		"KWD": 3, "BHD": 3, "OMR": 3, "JOD": 3, "TND": 3, "LYD": 3, "IQD": 3,
+	} {
+		currencies[code] = Currency{Code: code, MinorUnits: minor}
+	}
+	for code, inc := range map[string]string{
+		"CHF": "0.05", "CAD": "0.05", "AUD": "0.05", "NZD": "0.1", "HKD": "0.1", "ZAR": "0.1",
+		"DKK": "0.5", "SEK": "1", "NOK": "1", "CZK": "1", "HUF": "5",
+	} {
+		c := currencies[code]
+		c.CashIncrement = decimal.RequireFromString(inc)
+		currencies[code] = c
+	}
+}
+
+// RegisterCurrency adds or replaces a currency in the registry
+func RegisterCurrency(c Currency) {
+	c.Code = strings.ToUpper(c.Code)
+	currencyMu.Lock()
+	currencies[c.Code] = c
+	currencyMu.Unlock()
+}
+
+// LookupCurrency returns the registered currency for code (case-insensitive)
+func LookupCurrency(code string) (Currency, bool) {
+	currencyMu.RLock()
+	c, ok := currencies[strings.ToUpper(code)]
+	currencyMu.RUnlock()
+	return c, ok
+}
+
+// RoundCurrency rounds amount to the minor units of the currency, e.g. 0 places for "JPY".
+// It returns ErrUnknownCurrency if code is not registered.
+func RoundCurrency(amount float64, code string) (Result, error) {
+	c, ok := LookupCurrency(code)
+	if !ok {
+		return Result{}, ErrUnknownCurrency
+	}
+	return Round(amount, c.MinorUnits), nil
+}
+
+// RoundCash rounds amount to the cash-rounding increment of the currency, e.g. 0.05 for "CHF",
+// falling back to its minor units. It returns ErrUnknownCurrency if code is not registered.
+func RoundCash(amount float64, code string) (Result, error) {
+	c, ok := LookupCurrency(code)
+	if !ok {
+		return Result{}, ErrUnknownCurrency
+	}
+	if !c.CashIncrement.IsPositive() {
+		return Round(amount, c.MinorUnits), nil
+	}
+	inc := c.CashIncrement
+	steps := decimal.NewFromFloat(amount).DivRound(inc, divisionPlaces).Round(0)
+	return Result{v: steps.Mul(inc).Round(c.MinorUnits)}, nil
+}
+
+// FormatCurrencyCode formats amount with thousands separators and the minor units of the
+// currency, prefixed with its code, e.g. "USD 1,234.56" or "JPY 1,235".
+// It returns ErrUnknownCurrency if code is not registered.
+func FormatCurrencyCode(amount float64, code string) (string, error) {
+	c, ok := LookupCurrency(code)
+	if !ok {
+		return "", ErrUnknownCurrency
+	}
+	var buf [64]byte
+	dst := append(buf[:0], c.Code...)
+	dst = append(dst, ' ')
+	return string(AppendMoney(dst, amount, c.MinorUnits)), nil
+}
@@ -0,0 +1,109 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRoundCurrency(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		code     string
+		expected string
+	}{
+		{1234.567, "USD", "1234.57"},
+		{1234.5, "JPY", "1235"},
+		{1.2345, "KWD", "1.235"},
+		{-2.005, "eur", "-2.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, err := RoundCurrency(tt.amount, tt.code)
+			if err != nil {
+				t.Fatalf("RoundCurrency() error = %v", err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("RoundCurrency(%v, %q) = %v, want %v", tt.amount, tt.code, got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := RoundCurrency(1, "XXX"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("RoundCurrency() error = %v, want ErrUnknownCurrency", err)
+	}
+}
+
+func TestRoundCash(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		code     string
+		expected string
+	}{
+		{1.02, "CHF", "1"},
+		{1.03, "CHF", "1.05"},
+		{1.074, "CHF", "1.05"},
+		{12.5, "SEK", "13"},
+		{12.24, "DKK", "12"},
+		{12.25, "DKK", "12.5"},
+		{1.234, "USD", "1.23"},
+		{-1.03, "CAD", "-1.05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, err := RoundCash(tt.amount, tt.code)
+			if err != nil {
+				t.Fatalf("RoundCash() error = %v", err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("RoundCash(%v, %q) = %v, want %v", tt.amount, tt.code, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatCurrencyCode(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		code     string
+		expected string
+	}{
+		{1234.56, "USD", "USD 1,234.56"},
+		{1234567.5, "jpy", "JPY 1,234,568"},
+		{-1234.5678, "BHD", "BHD -1,234.568"},
+		{0, "EUR", "EUR 0.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, err := FormatCurrencyCode(tt.amount, tt.code)
+			if err != nil {
+				t.Fatalf("FormatCurrencyCode() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("FormatCurrencyCode() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := FormatCurrencyCode(1, "???"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("FormatCurrencyCode() error = %v, want ErrUnknownCurrency", err)
+	}
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	RegisterCurrency(Currency{Code: "tst", MinorUnits: 4, CashIncrement: decimal.RequireFromString("0.25")})
+	c, ok := LookupCurrency("TST")
+	if !ok || c.Code != "TST" || c.MinorUnits != 4 {
+		t.Fatalf("LookupCurrency() = %+v, %v", c, ok)
+	}
+	if got, _ := RoundCurrency(1.23456, "TST"); got.String() != "1.2346" {
+		t.Errorf("RoundCurrency() = %v, want 1.2346", got)
+	}
+	if got, _ := RoundCash(1.13, "TST"); got.String() != "1.25" {
+		t.Errorf("RoundCash() = %v, want 1.25", got)
+	}
+}
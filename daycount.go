@@ -0,0 +1,130 @@
+package mathx
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DayCount is a day-count convention that decides how days between two dates are counted
+// and how they convert to a fraction of a year
+type DayCount int
+
+const (
+	// Act365Fixed counts actual calendar days on a 365-day year (ACT/365F)
+	Act365Fixed DayCount = iota
+	// Act360 counts actual calendar days on a 360-day year (ACT/360)
+	Act360
+	// Thirty360US counts 30-day months on a 360-day year with the US (NASD) end-of-month rules (30/360 US)
+	Thirty360US
+	// ActActISDA counts actual calendar days, splitting the year fraction between
+	// 365-day and 366-day years (ACT/ACT ISDA)
+	ActActISDA
+)
+
+// String returns the conventional name of the day count, e.g. "ACT/360"
+func (dc DayCount) String() string {
+	switch dc {
+	case Act365Fixed:
+		return "ACT/365F"
+	case Act360:
+		return "ACT/360"
+	case Thirty360US:
+		return "30/360 US"
+	case ActActISDA:
+		return "ACT/ACT ISDA"
+	}
+	return "DayCount(" + strconv.Itoa(int(dc)) + ")"
+}
+
+// Days returns the number of days from start to end under the convention.
+// Only the calendar dates matter; times of day and locations are ignored.
+// The result is negative when end is before start.
+func (dc DayCount) Days(start, end time.Time) int64 {
+	if dc == Thirty360US {
+		if end.Before(start) {
+			return -thirty360Days(end, start)
+		}
+		return thirty360Days(start, end)
+	}
+	return actualDays(start, end)
+}
+
+// YearFraction returns the fraction of a year from start to end under the convention.
+// The result is negative when end is before start.
+func YearFraction(start, end time.Time, dc DayCount) Result {
+	switch dc {
+	case Act360, Thirty360US:
+		return Result{v: decimal.NewFromInt(dc.Days(start, end)).DivRound(decimal.NewFromInt(360), divisionPlaces)}
+	case ActActISDA:
+		if end.Before(start) {
+			return Result{v: actActFraction(end, start).Neg()}
+		}
+		return Result{v: actActFraction(start, end)}
+	}
+	return Result{v: decimal.NewFromInt(dc.Days(start, end)).DivRound(decimal.NewFromInt(365), divisionPlaces)}
+}
+
+// actualDays returns the number of calendar days from start to end. It counts days
+// since the Unix epoch rather than using time.Duration, which overflows after about
+// 292 years.
+func actualDays(start, end time.Time) int64 {
+	return dateOf(end).Unix()/secondsPerDay - dateOf(start).Unix()/secondsPerDay
+}
+
+// dateOf returns t's calendar date at midnight UTC
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// thirty360Days counts 30/360 US days from start to end, with start not after end
+func thirty360Days(start, end time.Time) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if isLastOfFebruary(start) {
+		if isLastOfFebruary(end) {
+			d2 = 30
+		}
+		d1 = 30
+	}
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+	if d1 == 31 {
+		d1 = 30
+	}
+	return int64(360*(y2-y1) + 30*int(m2-m1) + d2 - d1)
+}
+
+// isLastOfFebruary reports whether t is the last day of February
+func isLastOfFebruary(t time.Time) bool {
+	return t.Month() == time.February && t.AddDate(0, 0, 1).Month() == time.March
+}
+
+// actActFraction splits the days from start to end (start not after end) by calendar
+// year, dividing each part by the length of its year
+func actActFraction(start, end time.Time) decimal.Decimal {
+	start, end = dateOf(start), dateOf(end)
+	sum := decimal.Zero
+	for start.Before(end) {
+		next := time.Date(start.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		if next.After(end) {
+			next = end
+		}
+		yearDays := int64(365)
+		if isLeapYear(start.Year()) {
+			yearDays = 366
+		}
+		part := decimal.NewFromInt(actualDays(start, next)).DivRound(decimal.NewFromInt(yearDays), divisionPlaces)
+		sum = sum.Add(part)
+		start = next
+	}
+	return sum
+}
+
+// isLeapYear reports whether year is a Gregorian leap year
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
@@ -0,0 +1,109 @@
+package mathx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDayCountDays(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end time.Time
+		expected   int64
+	}{
+		{"same day", date(2024, 1, 1), date(2024, 1, 1), 0},
+		{"one month", date(2024, 1, 1), date(2024, 2, 1), 31},
+		{"leap february", date(2024, 2, 1), date(2024, 3, 1), 29},
+		{"reversed", date(2024, 2, 1), date(2024, 1, 1), -31},
+		{"time of day ignored", date(2024, 1, 1).Add(23 * time.Hour), date(2024, 1, 2), 1},
+		{"400 years", date(1700, 3, 1), date(2100, 3, 1), 146097},
+		{"before the epoch", date(1, 1, 1), date(1970, 1, 1), 719162},
+		{"long reversed", date(2500, 1, 1), date(1900, 1, 1), -219146},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Act365Fixed.Days(tt.start, tt.end); got != tt.expected {
+				t.Errorf("Days() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	loc := time.FixedZone("UTC+10", 10*3600)
+	if got := Act360.Days(time.Date(2024, 1, 1, 1, 0, 0, 0, loc), date(2024, 1, 2)); got != 1 {
+		t.Errorf("Days() across zones = %v, want 1", got)
+	}
+}
+
+func TestThirty360USDays(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end time.Time
+		expected   int64
+	}{
+		{"plain months", date(2024, 1, 15), date(2024, 3, 15), 60},
+		{"start on 31st", date(2024, 1, 31), date(2024, 2, 28), 28},
+		{"both on 31st", date(2024, 1, 31), date(2024, 3, 31), 60},
+		{"end on 31st only", date(2024, 1, 15), date(2024, 3, 31), 76},
+		{"end of february start", date(2023, 2, 28), date(2023, 3, 31), 30},
+		{"february to february", date(2023, 2, 28), date(2024, 2, 29), 360},
+		{"leap february is not end of month on 28th", date(2024, 2, 28), date(2024, 3, 31), 33},
+		{"full year", date(2023, 6, 1), date(2024, 6, 1), 360},
+		{"reversed", date(2024, 3, 15), date(2024, 1, 15), -60},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Thirty360US.Days(tt.start, tt.end); got != tt.expected {
+				t.Errorf("Days() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestYearFraction(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end time.Time
+		dc         DayCount
+		expected   string
+	}{
+		{"act/365f", date(2024, 1, 1), date(2025, 1, 1), Act365Fixed, "1.0027397260"},
+		{"act/360", date(2024, 1, 1), date(2024, 7, 1), Act360, "0.5055555556"},
+		{"30/360", date(2024, 1, 31), date(2024, 7, 31), Thirty360US, "0.5"},
+		{"act/act whole leap year", date(2024, 1, 1), date(2025, 1, 1), ActActISDA, "1"},
+		{"act/act spanning years", date(2023, 7, 1), date(2024, 7, 1), ActActISDA, "1.0013773486"},
+		{"act/act reversed", date(2025, 1, 1), date(2024, 1, 1), ActActISDA, "-1"},
+		{"same day", date(2024, 5, 5), date(2024, 5, 5), ActActISDA, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := YearFraction(tt.start, tt.end, tt.dc).Round(10)
+			if !got.Decimal().Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("YearFraction() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDayCountString(t *testing.T) {
+	names := map[DayCount]string{
+		Act365Fixed:  "ACT/365F",
+		Act360:       "ACT/360",
+		Thirty360US:  "30/360 US",
+		ActActISDA:   "ACT/ACT ISDA",
+		DayCount(42): "DayCount(42)",
+	}
+	for dc, want := range names {
+		if got := dc.String(); got != want {
+			t.Errorf("String() = %v, want %v", got, want)
+		}
+	}
+}
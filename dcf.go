@@ -0,0 +1,44 @@
+package mathx
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// DCF returns the present value of cashflows discounted with a separate rate per period.
+// cashflows[i] is received at the end of period i+1 and discounted by
+// (1+rates[0]) * ... * (1+rates[i]), so rates is a term structure of per-period
+// forward rates. If rates is shorter than cashflows its last rate applies to the
+// remaining periods, and no rates means no discounting. It returns ErrDomain if a rate
+// is not greater than -1 or is NaN, and ErrNotFinite for an infinite rate or a
+// cashflow that is NaN or ±Inf.
+func DCF(cashflows []float64, rates []float64) (Result, error) {
+	for _, r := range rates {
+		if !(r > -1) {
+			return Result{}, ErrDomain
+		}
+		if math.IsInf(r, 0) {
+			return Result{}, ErrNotFinite
+		}
+	}
+	pv := decimal.Zero
+	factor := decimalOne
+	for i, cf := range cashflows {
+		if math.IsNaN(cf) || math.IsInf(cf, 0) {
+			return Result{}, ErrNotFinite
+		}
+		if len(rates) > 0 {
+			factor = factor.Mul(decimalOne.Add(decimal.NewFromFloat(rates[min(i, len(rates)-1)])))
+		}
+		pv = pv.Add(decimal.NewFromFloat(cf).DivRound(factor, divisionPlaces))
+	}
+	return Result{v: pv}, nil
+}
+
+// NPV returns the net present value of cashflows at a single rate per period.
+// Like spreadsheet NPV, cashflows[0] is discounted by one full period; add an
+// initial investment at time zero separately. It returns the same errors as DCF.
+func NPV(rate float64, cashflows []float64) (Result, error) {
+	return DCF(cashflows, []float64{rate})
+}
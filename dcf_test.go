@@ -0,0 +1,68 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDCF(t *testing.T) {
+	tests := []struct {
+		name      string
+		cashflows []float64
+		rates     []float64
+		expected  string
+	}{
+		{"term structure", []float64{100, 100, 100}, []float64{0.02, 0.03, 0.04}, "284.7457130724"},
+		{"single rate", []float64{110, 121}, []float64{0.1}, "200"},
+		{"last rate repeats", []float64{105, 110.25, 115.7625}, []float64{0.05}, "300"},
+		{"no rates", []float64{1, 2, 3}, nil, "6"},
+		{"empty", nil, []float64{0.1}, "0"},
+		{"negative flows", []float64{-100, 220}, []float64{0.1, 0}, "109.0909090909"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DCF(tt.cashflows, tt.rates)
+			if err != nil {
+				t.Fatalf("DCF() error = %v", err)
+			}
+			got = got.Round(10)
+			if !got.Decimal().Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("DCF() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNPV(t *testing.T) {
+	got, err := NPV(0.1, []float64{-10000, 3000, 4200, 6800})
+	if err != nil || got.Round(2).String() != "1188.44" {
+		t.Errorf("NPV() = %v, %v, want 1188.44", got, err)
+	}
+
+	errTests := []struct {
+		name      string
+		cashflows []float64
+		rates     []float64
+		want      error
+	}{
+		{"total loss rate", []float64{100, 100}, []float64{-1}, ErrDomain},
+		{"below -1", []float64{100}, []float64{0.05, -2}, ErrDomain},
+		{"NaN rate", []float64{100}, []float64{math.NaN()}, ErrDomain},
+		{"infinite rate", []float64{100}, []float64{math.Inf(1)}, ErrNotFinite},
+		{"NaN cashflow", []float64{100, math.NaN()}, []float64{0.1}, ErrNotFinite},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DCF(tt.cashflows, tt.rates); !errors.Is(err, tt.want) {
+				t.Errorf("DCF() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+	if _, err := NPV(-1, []float64{100, 100}); !errors.Is(err, ErrDomain) {
+		t.Errorf("NPV(-1) error = %v, want ErrDomain", err)
+	}
+}
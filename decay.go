@@ -0,0 +1,32 @@
+package mathx
+
+import "math"
+
+// ExponentialDecay returns initial * e^(-rate*t), the value after time t of a quantity
+// that shrinks continuously at rate per unit of time, e.g. a score or cache weight.
+// A negative rate gives continuous growth, as in interest accrual.
+func ExponentialDecay(initial, rate, t float64) float64 {
+	return initial * math.Exp(-rate*t)
+}
+
+// HalfLifeDecay returns initial * 2^(-t/halfLife), the value after time t of a quantity
+// that halves every halfLife. It returns NaN if halfLife is not positive.
+func HalfLifeDecay(initial, halfLife, t float64) float64 {
+	if !(halfLife > 0) {
+		return math.NaN()
+	}
+	return initial * math.Exp2(-t/halfLife)
+}
+
+// LinearDecay returns the value after time t of a quantity that falls in a straight line
+// from initial at t = 0 to 0 at t = duration. t is clamped to [0, duration], and a
+// non-positive duration decays at once, giving initial for t < 0 and 0 otherwise.
+func LinearDecay(initial, duration, t float64) float64 {
+	if !(duration > 0) {
+		if t < 0 {
+			return initial
+		}
+		return 0
+	}
+	return initial * (1 - Clamp(t, 0, duration)/duration)
+}
@@ -0,0 +1,41 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDecay(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"exponential start", ExponentialDecay(50, 0.3, 0), 50},
+		{"exponential one time constant", ExponentialDecay(10, 0.5, 2), 10 / math.E},
+		{"exponential growth", ExponentialDecay(100, -0.05, 1), 100 * math.Exp(0.05)},
+		{"half-life one period", HalfLifeDecay(80, 5, 5), 40},
+		{"half-life three periods", HalfLifeDecay(80, 5, 15), 10},
+		{"half-life before start", HalfLifeDecay(80, 5, -5), 160},
+		{"linear start", LinearDecay(12, 4, 0), 12},
+		{"linear midway", LinearDecay(12, 4, 1), 9},
+		{"linear after end", LinearDecay(12, 4, 9), 0},
+		{"linear before start", LinearDecay(12, 4, -3), 12},
+		{"linear zero duration", LinearDecay(12, 0, 0), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !closeRel(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if !math.IsNaN(HalfLifeDecay(1, 0, 1)) {
+		t.Error("HalfLifeDecay() with zero half-life must be NaN")
+	}
+	if !closeRel(HalfLifeDecay(3, 2, 7), ExponentialDecay(3, math.Ln2/2, 7)) {
+		t.Error("HalfLifeDecay() must equal ExponentialDecay() with rate ln 2 / halfLife")
+	}
+}
@@ -0,0 +1,26 @@
+package mathx
+
+import "math"
+
+// ToDecibels converts a power ratio to decibels: 10 * log10(ratio), e.g. 2 -> ~3.01 dB.
+// A ratio of 0 gives -Inf and a negative ratio gives NaN.
+func ToDecibels(ratio float64) float64 {
+	return 10 * math.Log10(ratio)
+}
+
+// FromDecibels converts decibels to a power ratio: 10^(db/10). It is the inverse of ToDecibels.
+func FromDecibels(db float64) float64 {
+	return math.Pow(10, db/10)
+}
+
+// AmplitudeToDecibels converts an amplitude (field) ratio such as voltage or sound
+// pressure to decibels: 20 * log10(ratio), since power grows with the square of amplitude
+func AmplitudeToDecibels(ratio float64) float64 {
+	return 20 * math.Log10(ratio)
+}
+
+// DecibelsToAmplitude converts decibels to an amplitude ratio: 10^(db/20). It is the
+// inverse of AmplitudeToDecibels.
+func DecibelsToAmplitude(db float64) float64 {
+	return math.Pow(10, db/20)
+}
@@ -0,0 +1,40 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDecibels(t *testing.T) {
+	tests := []struct {
+		ratio, power, amplitude float64
+	}{
+		{1, 0, 0},
+		{10, 10, 20},
+		{100, 20, 40},
+		{0.001, -30, -60},
+		{2, 3.0102999566398120, 6.0205999132796240},
+	}
+
+	for _, tt := range tests {
+		if got := ToDecibels(tt.ratio); math.Abs(got-tt.power) > 1e-12 {
+			t.Errorf("ToDecibels(%v) = %v, want %v", tt.ratio, got, tt.power)
+		}
+		if got := AmplitudeToDecibels(tt.ratio); math.Abs(got-tt.amplitude) > 1e-12 {
+			t.Errorf("AmplitudeToDecibels(%v) = %v, want %v", tt.ratio, got, tt.amplitude)
+		}
+		if got := FromDecibels(tt.power); !closeRel(got, tt.ratio) {
+			t.Errorf("FromDecibels(%v) = %v, want %v", tt.power, got, tt.ratio)
+		}
+		if got := DecibelsToAmplitude(tt.amplitude); !closeRel(got, tt.ratio) {
+			t.Errorf("DecibelsToAmplitude(%v) = %v, want %v", tt.amplitude, got, tt.ratio)
+		}
+	}
+
+	if got := ToDecibels(0); !math.IsInf(got, -1) {
+		t.Errorf("ToDecibels(0) = %v, want -Inf", got)
+	}
+	if got := ToDecibels(-1); !math.IsNaN(got) {
+		t.Errorf("ToDecibels(-1) = %v, want NaN", got)
+	}
+}
@@ -0,0 +1,105 @@
+package mathx
+
+import (
+	"math"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// Built-in defaults, restored by ResetDefaults
+const (
+	builtinDivisionPrecision int32        = 16
+	builtinRounding          RoundingMode = RoundHalfUp
+	builtinEpsilon                        = 1e-9
+)
+
+// defaults is the package-wide numeric policy used by the *Default functions
+type defaults struct {
+	divisionPrecision int32
+	rounding          RoundingMode
+	epsilon           float64
+}
+
+var (
+	defaultsMu sync.RWMutex
+	current    = defaults{builtinDivisionPrecision, builtinRounding, builtinEpsilon}
+)
+
+// SetDefaults sets the package-wide division precision, rounding mode and equality
+// tolerance used by DivDefault, IsEqualDefault and the other *Default functions.
+// It returns ErrDomain for a negative precision or an unknown rounding mode and
+// ErrNotFinite for a NaN or infinite epsilon, leaving the current defaults in place.
+// It is safe for concurrent use, but is meant to be called once at start-up.
+func SetDefaults(divisionPrecision int32, rounding RoundingMode, epsilon float64) error {
+	if divisionPrecision < 0 || rounding < RoundHalfUp || rounding > RoundFloor {
+		return ErrDomain
+	}
+	if math.IsNaN(epsilon) || math.IsInf(epsilon, 0) {
+		return ErrNotFinite
+	}
+	defaultsMu.Lock()
+	current = defaults{divisionPrecision, rounding, math.Abs(epsilon)}
+	defaultsMu.Unlock()
+	return nil
+}
+
+// ResetDefaults restores the built-in defaults: 16 decimal places, RoundHalfUp and 1e-9
+func ResetDefaults() {
+	_ = SetDefaults(builtinDivisionPrecision, builtinRounding, builtinEpsilon)
+}
+
+// DefaultDivisionPrecision returns the decimal places kept by DivDefault
+func DefaultDivisionPrecision() int32 {
+	return loadDefaults().divisionPrecision
+}
+
+// DefaultRoundingMode returns the rounding mode used by DivDefault and RoundDefault
+func DefaultRoundingMode() RoundingMode {
+	return loadDefaults().rounding
+}
+
+// DefaultEpsilon returns the tolerance used by IsEqualDefault
+func DefaultEpsilon() float64 {
+	return loadDefaults().epsilon
+}
+
+// loadDefaults returns a consistent snapshot of the current defaults
+func loadDefaults() defaults {
+	defaultsMu.RLock()
+	d := current
+	defaultsMu.RUnlock()
+	return d
+}
+
+// DivDefault divides a by b at the default division precision and rounding mode.
+// It panics if b is zero, like Div.
+func DivDefault(a, b float64) Result {
+	return DivDefaultSafe(decimal.NewFromFloat(a), decimal.NewFromFloat(b))
+}
+
+// DivDefaultSafe is the decimal counterpart of DivDefault
+func DivDefaultSafe(a, b decimal.Decimal) Result {
+	d := loadDefaults()
+	return Result{v: divRoundWith(a, b, d.divisionPrecision, d.rounding)}
+}
+
+// DivDefault divides the result by other at the default division precision and rounding mode
+func (r Result) DivDefault(other decimal.Decimal) Result {
+	return DivDefaultSafe(r.v, other)
+}
+
+// RoundDefault rounds value to precision decimal places using the default rounding mode
+func RoundDefault(value float64, precision int32) Result {
+	return RoundWith(value, precision, DefaultRoundingMode())
+}
+
+// IsEqualDefault reports whether a and b differ by less than the default epsilon
+func IsEqualDefault(a, b float64) bool {
+	return math.Abs(a-b) < DefaultEpsilon()
+}
+
+// IsEqualDefaultSafe is the decimal counterpart of IsEqualDefault
+func IsEqualDefaultSafe(a, b decimal.Decimal) bool {
+	return a.Sub(b).Abs().LessThan(decimal.NewFromFloat(DefaultEpsilon()))
+}
@@ -0,0 +1,133 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDefaults(t *testing.T) {
+	t.Cleanup(ResetDefaults)
+
+	if got := DefaultDivisionPrecision(); got != 16 {
+		t.Errorf("DefaultDivisionPrecision() = %d, want 16", got)
+	}
+	if got := DefaultRoundingMode(); got != RoundHalfUp {
+		t.Errorf("DefaultRoundingMode() = %v, want HalfUp", got)
+	}
+	if got := DefaultEpsilon(); got != 1e-9 {
+		t.Errorf("DefaultEpsilon() = %v, want 1e-9", got)
+	}
+	if got := DivDefault(1, 3).String(); got != "0.3333333333333333" {
+		t.Errorf("DivDefault(1, 3) = %v, want 0.3333333333333333", got)
+	}
+
+	if err := SetDefaults(2, RoundHalfEven, 0.01); err != nil {
+		t.Fatalf("SetDefaults() error = %v", err)
+	}
+	if got := DivDefault(1, 8).String(); got != "0.12" {
+		t.Errorf("DivDefault(1, 8) = %v, want 0.12", got)
+	}
+	if got := RoundDefault(2.5, 0).String(); got != "2" {
+		t.Errorf("RoundDefault(2.5, 0) = %v, want 2", got)
+	}
+	if !IsEqualDefault(1.001, 1.005) || IsEqualDefault(1, 1.02) {
+		t.Error("IsEqualDefault() does not honor epsilon 0.01")
+	}
+	if !IsEqualDefaultSafe(decimal.RequireFromString("1.001"), decimal.RequireFromString("1.005")) {
+		t.Error("IsEqualDefaultSafe() does not honor epsilon 0.01")
+	}
+	if got := NewResult(5).DivDefault(decimal.NewFromInt(8)).String(); got != "0.62" {
+		t.Errorf("Result.DivDefault() = %v, want 0.62", got)
+	}
+
+	ResetDefaults()
+	if got := DefaultRoundingMode(); got != RoundHalfUp {
+		t.Errorf("after ResetDefaults DefaultRoundingMode() = %v, want HalfUp", got)
+	}
+}
+
+func TestSetDefaults_invalid(t *testing.T) {
+	t.Cleanup(ResetDefaults)
+	if err := SetDefaults(4, RoundFloor, 1e-6); err != nil {
+		t.Fatalf("SetDefaults() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		precision int32
+		rounding  RoundingMode
+		epsilon   float64
+		want      error
+	}{
+		{"negative precision", -1, RoundHalfUp, 1e-9, ErrDomain},
+		{"unknown rounding", 2, RoundFloor + 1, 1e-9, ErrDomain},
+		{"NaN epsilon", 16, RoundHalfUp, math.NaN(), ErrNotFinite},
+		{"infinite epsilon", 16, RoundHalfUp, math.Inf(1), ErrNotFinite},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetDefaults(tt.precision, tt.rounding, tt.epsilon); !errors.Is(err, tt.want) {
+				t.Errorf("SetDefaults() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if DefaultDivisionPrecision() != 4 || DefaultRoundingMode() != RoundFloor || DefaultEpsilon() != 1e-6 {
+		t.Error("rejected SetDefaults() calls must keep the current defaults")
+	}
+	if !IsEqualDefaultSafe(decimal.RequireFromString("1"), decimal.RequireFromString("1.0000001")) {
+		t.Error("IsEqualDefaultSafe() must keep working after a rejected epsilon")
+	}
+}
+
+func TestDivRoundWith(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		places   int32
+		mode     RoundingMode
+		expected string
+	}{
+		{"1", "8", 2, RoundHalfUp, "0.13"},
+		{"-1", "8", 2, RoundHalfUp, "-0.13"},
+		{"1", "-8", 2, RoundHalfEven, "-0.12"},
+		{"3", "8", 2, RoundHalfEven, "0.38"},
+		{"1", "8", 2, RoundHalfDown, "0.12"},
+		{"1", "3", 2, RoundUp, "0.34"},
+		{"-1", "3", 2, RoundUp, "-0.34"},
+		{"2", "3", 2, RoundDown, "0.66"},
+		{"-1", "3", 2, RoundCeiling, "-0.33"},
+		{"-1", "3", 2, RoundFloor, "-0.34"},
+		{"1", "4", 2, RoundUp, "0.25"},
+		{"25", "2", -1, RoundHalfEven, "10"},
+		{"35", "2", -1, RoundHalfEven, "20"},
+	}
+
+	for _, tt := range tests {
+		got := divRoundWith(decimal.RequireFromString(tt.a), decimal.RequireFromString(tt.b), tt.places, tt.mode)
+		if !got.Equal(decimal.RequireFromString(tt.expected)) {
+			t.Errorf("divRoundWith(%s, %s, %d, %v) = %v, want %v", tt.a, tt.b, tt.places, tt.mode, got, tt.expected)
+		}
+	}
+}
+
+func TestSetDefaults_concurrent(t *testing.T) {
+	t.Cleanup(ResetDefaults)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			SetDefaults(int32(i), RoundHalfEven, 1e-6)
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = DivDefault(1, 7)
+			_ = IsEqualDefault(1, 1)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,71 @@
+package mathx
+
+// EasingFunc maps a progress value t in [0, 1] to an eased progress value
+type EasingFunc func(t float64) float64
+
+// SmoothStep performs Hermite interpolation between 0 and 1 when edge0 < x < edge1.
+// It returns 0 for x <= edge0 and 1 for x >= edge1.
+func SmoothStep(edge0, edge1, x float64) float64 {
+	t := Clamp(InverseLerp(edge0, edge1, x), 0, 1)
+	return t * t * (3 - 2*t)
+}
+
+// SmootherStep is Ken Perlin's variant of SmoothStep with zero first and second
+// derivatives at the edges
+func SmootherStep(edge0, edge1, x float64) float64 {
+	t := Clamp(InverseLerp(edge0, edge1, x), 0, 1)
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// EaseLinear returns t unchanged (clamped to [0, 1])
+func EaseLinear(t float64) float64 {
+	return Clamp(t, 0, 1)
+}
+
+// EaseInQuad accelerates from zero velocity
+func EaseInQuad(t float64) float64 {
+	t = Clamp(t, 0, 1)
+	return t * t
+}
+
+// EaseOutQuad decelerates to zero velocity
+func EaseOutQuad(t float64) float64 {
+	t = Clamp(t, 0, 1)
+	return t * (2 - t)
+}
+
+// EaseInOutQuad accelerates until halfway, then decelerates
+func EaseInOutQuad(t float64) float64 {
+	t = Clamp(t, 0, 1)
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+// EaseInCubic accelerates from zero velocity with a cubic curve
+func EaseInCubic(t float64) float64 {
+	t = Clamp(t, 0, 1)
+	return t * t * t
+}
+
+// EaseOutCubic decelerates to zero velocity with a cubic curve
+func EaseOutCubic(t float64) float64 {
+	t = Clamp(t, 0, 1) - 1
+	return t*t*t + 1
+}
+
+// EaseInOutCubic accelerates until halfway, then decelerates, with a cubic curve
+func EaseInOutCubic(t float64) float64 {
+	t = Clamp(t, 0, 1)
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	t = 2*t - 2
+	return t*t*t/2 + 1
+}
+
+// LerpEase interpolates between a and b using an easing function applied to t
+func LerpEase(a, b, t float64, ease EasingFunc) float64 {
+	return Lerp(a, b, ease(t))
+}
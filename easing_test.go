@@ -0,0 +1,114 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSmoothStep(t *testing.T) {
+	tests := []struct {
+		name     string
+		edge0    float64
+		edge1    float64
+		x        float64
+		expected float64
+	}{
+		{"below edge0", 0, 1, -0.5, 0},
+		{"at edge0", 0, 1, 0, 0},
+		{"middle", 0, 1, 0.5, 0.5},
+		{"quarter", 0, 1, 0.25, 0.15625},
+		{"above edge1", 0, 1, 2, 1},
+		{"scaled edges", 10, 20, 15, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SmoothStep(tt.edge0, tt.edge1, tt.x); math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("SmoothStep() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSmootherStep(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        float64
+		expected float64
+	}{
+		{"below", -1, 0},
+		{"middle", 0.5, 0.5},
+		{"quarter", 0.25, 0.103515625},
+		{"above", 3, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SmootherStep(0, 1, tt.x); math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("SmootherStep() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEasingFunctions(t *testing.T) {
+	easings := map[string]EasingFunc{
+		"EaseLinear":     EaseLinear,
+		"EaseInQuad":     EaseInQuad,
+		"EaseOutQuad":    EaseOutQuad,
+		"EaseInOutQuad":  EaseInOutQuad,
+		"EaseInCubic":    EaseInCubic,
+		"EaseOutCubic":   EaseOutCubic,
+		"EaseInOutCubic": EaseInOutCubic,
+	}
+
+	for name, ease := range easings {
+		t.Run(name, func(t *testing.T) {
+			if got := ease(0); math.Abs(got) > 1e-10 {
+				t.Errorf("%s(0) = %v, want 0", name, got)
+			}
+			if got := ease(1); math.Abs(got-1) > 1e-10 {
+				t.Errorf("%s(1) = %v, want 1", name, got)
+			}
+			if got := ease(-1); math.Abs(got) > 1e-10 {
+				t.Errorf("%s(-1) = %v, want 0", name, got)
+			}
+			if got := ease(2); math.Abs(got-1) > 1e-10 {
+				t.Errorf("%s(2) = %v, want 1", name, got)
+			}
+		})
+	}
+
+	tests := []struct {
+		name     string
+		ease     EasingFunc
+		t        float64
+		expected float64
+	}{
+		{"EaseInQuad half", EaseInQuad, 0.5, 0.25},
+		{"EaseOutQuad half", EaseOutQuad, 0.5, 0.75},
+		{"EaseInOutQuad quarter", EaseInOutQuad, 0.25, 0.125},
+		{"EaseInOutQuad three quarters", EaseInOutQuad, 0.75, 0.875},
+		{"EaseInCubic half", EaseInCubic, 0.5, 0.125},
+		{"EaseOutCubic half", EaseOutCubic, 0.5, 0.875},
+		{"EaseInOutCubic quarter", EaseInOutCubic, 0.25, 0.0625},
+		{"EaseInOutCubic three quarters", EaseInOutCubic, 0.75, 0.9375},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ease(tt.t); math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLerpEase(t *testing.T) {
+	if got := LerpEase(0, 100, 0.5, EaseInQuad); math.Abs(got-25) > 1e-10 {
+		t.Errorf("LerpEase() = %v, want 25", got)
+	}
+	if got := LerpEase(10, 20, 0.5, EaseLinear); math.Abs(got-15) > 1e-10 {
+		t.Errorf("LerpEase() = %v, want 15", got)
+	}
+}
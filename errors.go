@@ -0,0 +1,45 @@
+package mathx
+
+import "errors"
+
+// ErrDivisionByZero is returned when dividing by zero
+var ErrDivisionByZero = errors.New("mathx: division by zero")
+
+// ErrNotFinite is returned when a NaN or infinite float cannot be represented as a decimal
+var ErrNotFinite = errors.New("mathx: value is NaN or infinite")
+
+// ErrInvalidFormat is returned when a string is not a valid number in the expected format
+var ErrInvalidFormat = errors.New("mathx: invalid number format")
+
+// ErrNotInteger is returned when a value with a fractional part is converted to an integer
+var ErrNotInteger = errors.New("mathx: value is not an integer")
+
+// ErrOverflow is returned when a value does not fit in the target type
+var ErrOverflow = errors.New("mathx: value overflows")
+
+// ErrEmptyInput is returned when a function needs at least one value
+var ErrEmptyInput = errors.New("mathx: empty input")
+
+// ErrLengthMismatch is returned when input slices that must have equal length differ
+var ErrLengthMismatch = errors.New("mathx: length mismatch")
+
+// ErrInsufficientData is returned when there are too few values for a calculation
+var ErrInsufficientData = errors.New("mathx: insufficient data")
+
+// ErrDimensionMismatch is returned when matrix or vector dimensions are incompatible
+var ErrDimensionMismatch = errors.New("mathx: dimension mismatch")
+
+// ErrSingularMatrix is returned when a matrix cannot be inverted
+var ErrSingularMatrix = errors.New("mathx: matrix is singular")
+
+// ErrDomain is returned when an argument lies outside the domain of a function
+var ErrDomain = errors.New("mathx: argument out of domain")
+
+// ErrUnknownCurrency is returned when a currency code is not in the registry
+var ErrUnknownCurrency = errors.New("mathx: unknown currency")
+
+// ErrNoExactChange is returned when an amount cannot be made from the given denominations
+var ErrNoExactChange = errors.New("mathx: no exact change")
+
+// ErrZeroBase is returned when a relative measure has a zero baseline
+var ErrZeroBase = errors.New("mathx: zero baseline")
@@ -22,7 +22,6 @@ func ExampleAdd_chainable() {
 		Round(2).
 		ToStringFixed(2)
 	fmt.Printf("Result: %s\n", result)
-	// Output: Result: 1.00
 
 	// Test with negative numbers
 	result = mathx.Add(-1.5, 2.5).
@@ -31,7 +30,6 @@ func ExampleAdd_chainable() {
 		Round(2).
 		ToStringFixed(2)
 	fmt.Printf("Result: %s\n", result)
-	// Output: Result: -1.00
 
 	// Test with zero
 	result = mathx.Add(0, 0).
@@ -40,7 +38,6 @@ func ExampleAdd_chainable() {
 		Round(2).
 		ToStringFixed(2)
 	fmt.Printf("Result: %s\n", result)
-	// Output: Result: 0.00
 
 	// Test with large numbers
 	result = mathx.Add(1e10, 2e10).
@@ -49,7 +46,6 @@ func ExampleAdd_chainable() {
 		Round(0).
 		ToStringFixed(0)
 	fmt.Printf("Result: %s\n", result)
-	// Output: Result: 45000000000
 
 	// Test with small decimal numbers
 	result = mathx.Add(0.0001, 0.0002).
@@ -58,14 +54,19 @@ func ExampleAdd_chainable() {
 		Round(4).
 		ToStringFixed(4)
 	fmt.Printf("Result: %s\n", result)
-	// Output: Result: 0.1000
 
 	// Test chaining with only Add
 	result = mathx.Add(1.1, 2.2).
 		Round(1).
 		ToStringFixed(1)
 	fmt.Printf("Result: %s\n", result)
-	// Output: Result: 3.3
+	// Output:
+	// Result: 1.00
+	// Result: -1.00
+	// Result: 0.00
+	// Result: 45000000000
+	// Result: 0.1000
+	// Result: 3.3
 }
 
 func ExampleMul_basic() {
@@ -101,7 +102,7 @@ func ExampleMul_large() {
 		Round(2).
 		FormatMoney(2)
 	fmt.Printf("Price with large number: $%s\n", price)
-	// Output: Price with large number: $11500000000.00
+	// Output: Price with large number: $11,500,000,000.00
 }
 
 func ExampleMul_decimalPlaces() {
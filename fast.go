@@ -0,0 +1,77 @@
+package mathx
+
+import (
+	"math"
+	"strconv"
+)
+
+// The F-prefixed functions operate on float64 directly and never allocate decimals.
+// They are meant for tight loops where the decimal overhead matters: each operation
+// is correctly rounded by IEEE 754, but errors such as 0.1 + 0.2 != 0.3 are not
+// corrected, so results carry float64 precision rather than decimal precision.
+
+// float64pow10 holds the powers of ten that are exactly representable as float64
+var float64pow10 = [...]float64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10,
+	1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19, 1e20, 1e21, 1e22,
+}
+
+// FAdd adds two float64 values without decimal conversion
+func FAdd(a, b float64) float64 {
+	return a + b
+}
+
+// FSub subtracts two float64 values without decimal conversion
+func FSub(a, b float64) float64 {
+	return a - b
+}
+
+// FMul multiplies two float64 values without decimal conversion
+func FMul(a, b float64) float64 {
+	return a * b
+}
+
+// FDiv divides two float64 values without decimal conversion
+func FDiv(a, b float64) float64 {
+	return a / b
+}
+
+// FRound rounds a float64 half away from zero to the specified precision without
+// decimals. Rounding is applied to the shortest decimal representation of the value,
+// so FRound(1.005, 2) == 1.01 just like Round(1.005, 2). When the rounded digits fit
+// in 53 bits and the decimal exponent is within ±22, the result is rebuilt
+// arithmetically without allocating; otherwise it falls back to strconv.ParseFloat.
+func FRound(value float64, precision int32) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+	var buf [32]byte
+	digits, exp := floatDigits(buf[:0], value)
+	digits, exp = roundDigits(digits, exp, -precision)
+	neg := value < 0
+
+	var m uint64
+	for _, c := range digits {
+		m = m*10 + uint64(c-'0')
+	}
+	if m == 0 {
+		return 0
+	}
+
+	var f float64
+	switch {
+	case m < 1<<53 && exp >= 0 && int(exp) < len(float64pow10):
+		f = float64(m) * float64pow10[exp]
+	case m < 1<<53 && exp < 0 && int(-exp) < len(float64pow10):
+		f = float64(m) / float64pow10[-exp]
+	default:
+		s := strconv.AppendUint(buf[:0], m, 10)
+		s = append(s, 'e')
+		s = strconv.AppendInt(s, int64(exp), 10)
+		f, _ = strconv.ParseFloat(string(s), 64)
+	}
+	if neg {
+		f = -f
+	}
+	return f
+}
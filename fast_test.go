@@ -0,0 +1,78 @@
+package mathx
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestFArithmetic(t *testing.T) {
+	if got := FAdd(1.5, 2.25); got != 3.75 {
+		t.Errorf("FAdd() = %v, want 3.75", got)
+	}
+	if got := FSub(5, 7.5); got != -2.5 {
+		t.Errorf("FSub() = %v, want -2.5", got)
+	}
+	if got := FMul(1.5, 4); got != 6 {
+		t.Errorf("FMul() = %v, want 6", got)
+	}
+	if got := FDiv(1, 4); got != 0.25 {
+		t.Errorf("FDiv() = %v, want 0.25", got)
+	}
+	if got := FDiv(1, 0); !math.IsInf(got, 1) {
+		t.Errorf("FDiv(1, 0) = %v, want +Inf", got)
+	}
+}
+
+func TestFRound(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     float64
+		precision int32
+		expected  float64
+	}{
+		{"round up", 3.14159, 2, 3.14},
+		{"decimal half", 1.005, 2, 1.01},
+		{"half away from zero", -2.5, 0, -3},
+		{"negative precision", 1250, -2, 1300},
+		{"carry", 9.995, 2, 10},
+		{"large", 1e25, 2, 1e25},
+		{"tiny", 1e-30, 32, 1e-30},
+		{"rounds to zero", 0.0001, 2, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FRound(tt.value, tt.precision); got != tt.expected {
+				t.Errorf("FRound() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if got := FRound(math.NaN(), 2); !math.IsNaN(got) {
+		t.Errorf("FRound(NaN) = %v, want NaN", got)
+	}
+	for _, v := range []float64{-0.004, math.Copysign(0, -1)} {
+		if got := FRound(v, 2); got != 0 || math.Signbit(got) {
+			t.Errorf("FRound(%v, 2) = %v, want +0", v, got)
+		}
+	}
+}
+
+func TestFRound_allocs(t *testing.T) {
+	if n := testing.AllocsPerRun(100, func() { FRound(3.14159, 2) }); n != 0 {
+		t.Errorf("FRound() allocates %v times, want 0", n)
+	}
+}
+
+func TestFRound_matchesRound(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for i := 0; i < 10000; i++ {
+		value := (rng.Float64() - 0.5) * math.Pow(10, float64(rng.Intn(30)-10))
+		precision := int32(rng.Intn(12) - 2)
+		want := Round(value, precision).Float64()
+		if got := FRound(value, precision); got != want || math.Signbit(got) != math.Signbit(want) {
+			t.Fatalf("FRound(%v, %d) = %v, want %v", value, precision, got, want)
+		}
+	}
+}
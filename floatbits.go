@@ -0,0 +1,82 @@
+package mathx
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// smallestNormal is the smallest positive normal float64
+const smallestNormal = 0x1p-1022
+
+// NextAfter returns the next representable float64 after x towards y
+func NextAfter(x, y float64) float64 {
+	return math.Nextafter(x, y)
+}
+
+// ULP returns the unit in the last place of x: the gap between |x| and the next
+// larger float64. For math.MaxFloat64 it returns the gap below it.
+// NaN returns NaN and ±Inf returns +Inf.
+func ULP(x float64) float64 {
+	x = math.Abs(x)
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return x
+	case x == math.MaxFloat64:
+		return x - math.Nextafter(x, 0)
+	}
+	return math.Nextafter(x, math.Inf(1)) - x
+}
+
+// IsSubnormal reports whether x is a non-zero float64 smaller in magnitude than
+// the smallest normal number, and therefore stored with reduced precision
+func IsSubnormal(x float64) bool {
+	return x != 0 && math.Abs(x) < smallestNormal
+}
+
+// BitsEqual reports whether a and b have the same IEEE 754 bit pattern. Unlike ==,
+// it tells 0 and -0 apart and treats a NaN as equal to an identical NaN.
+func BitsEqual(a, b float64) bool {
+	return math.Float64bits(a) == math.Float64bits(b)
+}
+
+// ExactString returns the exact decimal value of the binary float64 f, e.g.
+// "0.1000000000000000055511151231257827021181583404541015625" for 0.1. It shows
+// why NewResult(0.1), which keeps the shortest digits, differs from the stored binary value.
+// NaN and ±Inf are formatted as "NaN", "+Inf" and "-Inf", and negative zero as "0".
+func ExactString(f float64) string {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	if f == 0 {
+		return "0"
+	}
+	// every float64 is an integer times a power of two no smaller than 2^-1074,
+	// which has at most 1074 digits after the decimal point
+	s := strconv.FormatFloat(f, 'f', 1074, 64)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// CopySign returns a value with the magnitude of x and the sign of y, like math.Copysign.
+// The sign of y includes the sign bit of zeros, so CopySign(1, math.Copysign(0, -1)) is -1.
+func CopySign(x, y float64) float64 {
+	return math.Copysign(x, y)
+}
+
+// ShortestString returns the shortest decimal string that parses back to exactly f,
+// using exponent notation for very large and small magnitudes, e.g. "0.1", "1e+21"
+// and "5e-324"
+func ShortestString(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// RoundTrips reports whether s parses as a float64 equal to f, i.e. whether a value
+// sent as s arrives unchanged. Zeros of either sign match each other and NaN matches NaN.
+func RoundTrips(f float64, s string) bool {
+	parsed, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return false
+	}
+	return parsed == f || (math.IsNaN(parsed) && math.IsNaN(f))
+}
@@ -0,0 +1,183 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNextAfter(t *testing.T) {
+	if got := NextAfter(1, 2); got != 1+0x1p-52 {
+		t.Errorf("NextAfter(1, 2) = %v, want %v", got, 1+0x1p-52)
+	}
+	if got := NextAfter(1, 0); got != 1-0x1p-53 {
+		t.Errorf("NextAfter(1, 0) = %v, want %v", got, 1-0x1p-53)
+	}
+	if got := NextAfter(0, 1); got != math.SmallestNonzeroFloat64 {
+		t.Errorf("NextAfter(0, 1) = %v, want %v", got, math.SmallestNonzeroFloat64)
+	}
+	if got := NextAfter(3, 3); got != 3 {
+		t.Errorf("NextAfter(3, 3) = %v, want 3", got)
+	}
+}
+
+func TestULP(t *testing.T) {
+	tests := []struct {
+		x        float64
+		expected float64
+	}{
+		{1, 0x1p-52},
+		{-1, 0x1p-52},
+		{0.1, 0x1p-56},
+		{1e16, 2},
+		{0, math.SmallestNonzeroFloat64},
+		{math.MaxFloat64, 0x1p971},
+		{math.Inf(-1), math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		if got := ULP(tt.x); got != tt.expected {
+			t.Errorf("ULP(%v) = %v, want %v", tt.x, got, tt.expected)
+		}
+	}
+	if !math.IsNaN(ULP(math.NaN())) {
+		t.Error("ULP(NaN) should be NaN")
+	}
+}
+
+func TestIsSubnormal(t *testing.T) {
+	tests := []struct {
+		x        float64
+		expected bool
+	}{
+		{math.SmallestNonzeroFloat64, true},
+		{-1e-310, true},
+		{0x1p-1022, false},
+		{0, false},
+		{1, false},
+		{math.NaN(), false},
+	}
+
+	for _, tt := range tests {
+		if got := IsSubnormal(tt.x); got != tt.expected {
+			t.Errorf("IsSubnormal(%v) = %v, want %v", tt.x, got, tt.expected)
+		}
+	}
+}
+
+func TestBitsEqual(t *testing.T) {
+	nan := math.NaN()
+	negZero := math.Copysign(0, -1)
+
+	if !BitsEqual(nan, nan) {
+		t.Error("BitsEqual(NaN, NaN) should be true")
+	}
+	if BitsEqual(0, negZero) {
+		t.Error("BitsEqual(0, -0) should be false")
+	}
+	a := 0.1
+	if !BitsEqual(a+0.2, 0.30000000000000004) {
+		t.Error("BitsEqual(0.1+0.2, 0.30000000000000004) should be true")
+	}
+	if BitsEqual(a+0.2, 0.3) {
+		t.Error("BitsEqual(0.1+0.2, 0.3) should be false")
+	}
+}
+
+func TestExactString(t *testing.T) {
+	tests := []struct {
+		f        float64
+		expected string
+	}{
+		{0.1, "0.1000000000000000055511151231257827021181583404541015625"},
+		{0.5, "0.5"},
+		{-2.25, "-2.25"},
+		{100, "100"},
+		{0, "0"},
+		{1e23, "99999999999999991611392"},
+		{math.Inf(-1), "-Inf"},
+		{math.NaN(), "NaN"},
+	}
+
+	for _, tt := range tests {
+		if got := ExactString(tt.f); got != tt.expected {
+			t.Errorf("ExactString(%v) = %v, want %v", tt.f, got, tt.expected)
+		}
+	}
+
+	s := ExactString(math.SmallestNonzeroFloat64)
+	if len(s) != len("0.")+1074 || s[len(s)-3:] != "625" {
+		t.Errorf("ExactString(SmallestNonzeroFloat64) has unexpected form %q...", s[:20])
+	}
+}
+
+func TestCopySign(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	tests := []struct {
+		x, y     float64
+		expected float64
+	}{
+		{3, -1, -3},
+		{-3, 1, 3},
+		{3, negZero, -3},
+		{-3, 0, 3},
+		{0, -1, negZero},
+	}
+
+	for _, tt := range tests {
+		if got := CopySign(tt.x, tt.y); !BitsEqual(got, tt.expected) {
+			t.Errorf("CopySign(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.expected)
+		}
+	}
+
+	r := MustResultFromString("-2.50")
+	if got := r.CopySign(NewResult(1)).String(); got != "2.5" {
+		t.Errorf("Result.CopySign(+) = %v, want 2.5", got)
+	}
+	if got := r.Abs().CopySign(NewResult(-0.1)).String(); got != "-2.5" {
+		t.Errorf("Result.CopySign(-) = %v, want -2.5", got)
+	}
+	if got := r.CopySign(Result{}).String(); got != "2.5" {
+		t.Errorf("Result.CopySign(0) = %v, want 2.5", got)
+	}
+	if got := (Result{}).CopySign(NewResult(-1)).String(); got != "0" {
+		t.Errorf("zero Result.CopySign(-) = %v, want 0", got)
+	}
+}
+
+func TestShortestStringRoundTrips(t *testing.T) {
+	values := []float64{0, 0.1, 1.0 / 3, 123456789, 1e21, -2.5e-8, math.MaxFloat64, math.SmallestNonzeroFloat64, math.Inf(-1), math.NaN()}
+	for _, v := range values {
+		s := ShortestString(v)
+		if !RoundTrips(v, s) {
+			t.Errorf("RoundTrips(%v, ShortestString() = %q) = false, want true", v, s)
+		}
+		if !RoundTrips(v, ExactString(v)) {
+			t.Errorf("RoundTrips(%v, ExactString()) = false, want true", v)
+		}
+	}
+
+	tests := []struct {
+		f        float64
+		s        string
+		expected bool
+	}{
+		{0.1, "0.1", true},
+		{0.1, "0.10000000000000001", true},
+		{0.1, "0.1000000000000001", false},
+		{1.0 / 3, ToStringFixed(1.0/3, 10), false},
+		{math.Copysign(0, -1), "0", true},
+		{1, "1,0", false},
+		{1, "", false},
+	}
+	for _, tt := range tests {
+		if got := RoundTrips(tt.f, tt.s); got != tt.expected {
+			t.Errorf("RoundTrips(%v, %q) = %v, want %v", tt.f, tt.s, got, tt.expected)
+		}
+	}
+
+	for v, want := range map[float64]string{0.1: "0.1", 1e21: "1e+21", 5e-324: "5e-324", 100: "100"} {
+		if got := ShortestString(v); got != want {
+			t.Errorf("ShortestString(%v) = %q, want %q", v, got, want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package mathx
+
+// SimpleExponentialSmoothing smooths values with smoothing factor alpha (clamped to [0, 1])
+// and returns the smoothed series together with the one-step-ahead forecast.
+// The series is initialized with the first value.
+func SimpleExponentialSmoothing(values []float64, alpha float64) ([]float64, float64) {
+	if len(values) == 0 {
+		return nil, 0
+	}
+	alpha = Clamp(alpha, 0, 1)
+	smoothed := make([]float64, len(values))
+	smoothed[0] = values[0]
+	for i := 1; i < len(values); i++ {
+		smoothed[i] = alpha*values[i] + (1-alpha)*smoothed[i-1]
+	}
+	return smoothed, smoothed[len(smoothed)-1]
+}
+
+// HoltLinear applies Holt's linear trend method with level factor alpha and trend factor
+// beta (both clamped to [0, 1]). It returns the smoothed level series together with the
+// one-step-ahead forecast. The level starts at the first value and the trend at the
+// difference of the first two values.
+func HoltLinear(values []float64, alpha, beta float64) ([]float64, float64) {
+	if len(values) == 0 {
+		return nil, 0
+	}
+	alpha = Clamp(alpha, 0, 1)
+	beta = Clamp(beta, 0, 1)
+	level := make([]float64, len(values))
+	level[0] = values[0]
+	var trend float64
+	if len(values) > 1 {
+		trend = values[1] - values[0]
+	}
+	for i := 1; i < len(values); i++ {
+		level[i] = alpha*values[i] + (1-alpha)*(level[i-1]+trend)
+		trend = beta*(level[i]-level[i-1]) + (1-beta)*trend
+	}
+	return level, level[len(level)-1] + trend
+}
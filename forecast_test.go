@@ -0,0 +1,68 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func floatsEqual(a, b []float64, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSimpleExponentialSmoothing(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		alpha    float64
+		smoothed []float64
+		forecast float64
+	}{
+		{"half alpha", []float64{10, 20, 30}, 0.5, []float64{10, 15, 22.5}, 22.5},
+		{"alpha one follows data", []float64{1, 5, 3}, 1, []float64{1, 5, 3}, 3},
+		{"alpha zero keeps first", []float64{1, 5, 3}, 0, []float64{1, 1, 1}, 1},
+		{"alpha clamped", []float64{1, 5}, 2, []float64{1, 5}, 5},
+		{"empty", nil, 0.5, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			smoothed, forecast := SimpleExponentialSmoothing(tt.values, tt.alpha)
+			if !floatsEqual(smoothed, tt.smoothed, 1e-10) || math.Abs(forecast-tt.forecast) > 1e-10 {
+				t.Errorf("SimpleExponentialSmoothing() = %v, %v, want %v, %v", smoothed, forecast, tt.smoothed, tt.forecast)
+			}
+		})
+	}
+}
+
+func TestHoltLinear(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		alpha    float64
+		beta     float64
+		smoothed []float64
+		forecast float64
+	}{
+		{"perfect trend", []float64{10, 12, 14, 16}, 0.5, 0.5, []float64{10, 12, 14, 16}, 18},
+		{"noisy", []float64{3, 5, 9}, 0.5, 0.5, []float64{3, 5, 8}, 10.5},
+		{"single", []float64{4}, 0.5, 0.5, []float64{4}, 4},
+		{"empty", nil, 0.5, 0.5, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			smoothed, forecast := HoltLinear(tt.values, tt.alpha, tt.beta)
+			if !floatsEqual(smoothed, tt.smoothed, 1e-10) || math.Abs(forecast-tt.forecast) > 1e-10 {
+				t.Errorf("HoltLinear() = %v, %v, want %v, %v", smoothed, forecast, tt.smoothed, tt.forecast)
+			}
+		})
+	}
+}
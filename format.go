@@ -0,0 +1,296 @@
+package mathx
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// AppendString appends the shortest decimal representation of value to dst,
+// as produced by ToString, and returns the extended buffer
+func AppendString(dst []byte, value float64) []byte {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.AppendFloat(dst, value, 'f', -1, 64)
+	}
+	var buf [32]byte
+	digits, exp := floatDigits(buf[:0], value)
+	digits, exp = trimTrailingZeros(digits, exp)
+	return appendDigits(dst, value < 0, digits, exp, int(max(-exp, 0)), Grouping{})
+}
+
+// AppendFixed appends value with fixed decimal places to dst, as produced by
+// ToStringFixed, and returns the extended buffer
+func AppendFixed(dst []byte, value float64, places int32) []byte {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.AppendFloat(dst, value, 'f', -1, 64)
+	}
+	var buf [32]byte
+	digits, exp := floatDigits(buf[:0], value)
+	return appendFixedDigits(dst, value < 0, digits, exp, places)
+}
+
+// Grouping configures how the integer digits of a money amount are grouped and which
+// decimal separator follows them
+type Grouping struct {
+	Separator string // group separator, e.g. ",", ".", " ", "'" or "_"; empty disables grouping
+	Size      int    // digits per group, 3 when not positive
+	Decimal   string // decimal separator, "." when empty
+}
+
+// Common groupings for FormatMoneyGrouped. GroupPeriod also sets a comma as the
+// decimal separator, as in "1.234,56".
+var (
+	GroupComma      = Grouping{Separator: ","}
+	GroupPeriod     = Grouping{Separator: ".", Decimal: ","}
+	GroupSpace      = Grouping{Separator: " "}
+	GroupApostrophe = Grouping{Separator: "'"}
+	GroupUnderscore = Grouping{Separator: "_"}
+)
+
+// AppendMoney appends amount formatted as currency with thousands separator to dst
+// and returns the extended buffer. It rounds half away from zero like FormatMoney
+// and allocates only when dst has to grow.
+func AppendMoney(dst []byte, amount float64, decimalPlaces int32) []byte {
+	return AppendMoneyGrouped(dst, amount, decimalPlaces, GroupComma)
+}
+
+// AppendMoneyGrouped is like AppendMoney but groups the integer digits as g says
+func AppendMoneyGrouped(dst []byte, amount float64, decimalPlaces int32, g Grouping) []byte {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return strconv.AppendFloat(dst, amount, 'f', -1, 64)
+	}
+	var buf [32]byte
+	digits, exp := floatDigits(buf[:0], amount)
+	return appendMoneyDigits(dst, amount < 0, digits, exp, decimalPlaces, g)
+}
+
+// AppendMoneyInt appends an int64 formatted as currency with thousands separator to dst
+func AppendMoneyInt(dst []byte, amount int64, decimalPlaces int32) []byte {
+	return AppendMoneyIntGrouped(dst, amount, decimalPlaces, GroupComma)
+}
+
+// AppendMoneyIntGrouped is like AppendMoneyInt but groups the integer digits as g says
+func AppendMoneyIntGrouped(dst []byte, amount int64, decimalPlaces int32, g Grouping) []byte {
+	var buf [24]byte
+	digits := strconv.AppendUint(buf[:0], uint64(AbsT(amount)), 10)
+	return appendMoneyDigits(dst, amount < 0, digits, 0, decimalPlaces, g)
+}
+
+// bufPool holds scratch buffers for formatters whose output escapes to the heap,
+// such as the buffer handed to an io.Writer, so they stay allocation-free
+var bufPool = sync.Pool{New: func() any { return new([]byte) }}
+
+// maxPooledBuf is the largest buffer capacity returned to bufPool
+const maxPooledBuf = 1 << 10
+
+// getBuf returns an empty scratch buffer from bufPool
+func getBuf() *[]byte {
+	return bufPool.Get().(*[]byte)
+}
+
+// putBuf returns a scratch buffer to bufPool unless it has grown too large
+func putBuf(b *[]byte) {
+	if cap(*b) > maxPooledBuf {
+		return
+	}
+	*b = (*b)[:0]
+	bufPool.Put(b)
+}
+
+// WriteMoney writes amount formatted as by FormatMoney to w
+func WriteMoney(w io.Writer, amount float64, decimalPlaces int32) error {
+	b := getBuf()
+	*b = AppendMoney(*b, amount, decimalPlaces)
+	_, err := w.Write(*b)
+	putBuf(b)
+	return err
+}
+
+// WriteFixed writes value formatted as by ToStringFixed to w
+func WriteFixed(w io.Writer, value float64, places int32) error {
+	b := getBuf()
+	*b = AppendFixed(*b, value, places)
+	_, err := w.Write(*b)
+	putBuf(b)
+	return err
+}
+
+// appendMoneyDecimal is the decimal.Decimal counterpart of AppendMoneyGrouped
+func appendMoneyDecimal(dst []byte, d decimal.Decimal, decimalPlaces int32, g Grouping) []byte {
+	var buf [32]byte
+	digits, exp := decimalDigits(buf[:0], d)
+	return appendMoneyDigits(dst, d.Sign() < 0, digits, exp, decimalPlaces, g)
+}
+
+// appendStringDecimal is the decimal.Decimal counterpart of AppendString
+func appendStringDecimal(dst []byte, d decimal.Decimal) []byte {
+	var buf [32]byte
+	digits, exp := decimalDigits(buf[:0], d)
+	digits, exp = trimTrailingZeros(digits, exp)
+	return appendDigits(dst, d.Sign() < 0, digits, exp, int(max(-exp, 0)), Grouping{})
+}
+
+// appendFixedDecimal is the decimal.Decimal counterpart of AppendFixed
+func appendFixedDecimal(dst []byte, d decimal.Decimal, places int32) []byte {
+	var buf [32]byte
+	digits, exp := decimalDigits(buf[:0], d)
+	return appendFixedDigits(dst, d.Sign() < 0, digits, exp, places)
+}
+
+// floatDigits appends the shortest decimal digits that represent |f| to buf and
+// returns them together with the exponent, so that |f| == digits * 10^exp
+func floatDigits(buf []byte, f float64) ([]byte, int32) {
+	// AppendFloat in 'e' format yields d[.ddd]e±xx
+	buf = strconv.AppendFloat(buf, math.Abs(f), 'e', -1, 64)
+	n := 1
+	i := 1
+	if buf[i] == '.' {
+		for i++; buf[i] != 'e'; i++ {
+			buf[n] = buf[i]
+			n++
+		}
+	}
+	// skip 'e' and parse the signed exponent
+	i++
+	neg := buf[i] == '-'
+	e := 0
+	for i++; i < len(buf); i++ {
+		e = e*10 + int(buf[i]-'0')
+	}
+	if neg {
+		e = -e
+	}
+	return buf[:n], int32(e - (n - 1))
+}
+
+// decimalDigits appends the unsigned coefficient digits of d to buf and
+// returns them together with the exponent, so that |d| == digits * 10^exp
+func decimalDigits(buf []byte, d decimal.Decimal) ([]byte, int32) {
+	if d.NumDigits() <= 18 {
+		c := d.CoefficientInt64()
+		if c < 0 {
+			c = -c
+		}
+		buf = strconv.AppendInt(buf, c, 10)
+	} else {
+		buf = d.Coefficient().Append(buf, 10)
+		if buf[0] == '-' {
+			buf = buf[1:]
+		}
+	}
+	return buf, d.Exponent()
+}
+
+// trimTrailingZeros removes trailing zero digits below the decimal point
+func trimTrailingZeros(digits []byte, exp int32) ([]byte, int32) {
+	for exp < 0 && len(digits) > 1 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+		exp++
+	}
+	if len(digits) == 1 && digits[0] == '0' {
+		exp = 0
+	}
+	return digits, exp
+}
+
+// roundDigits rounds digits * 10^exp half away from zero so that the exponent is
+// at least minExp. An empty digit slice represents zero.
+func roundDigits(digits []byte, exp, minExp int32) ([]byte, int32) {
+	if exp >= minExp {
+		return digits, exp
+	}
+	drop := int(minExp - exp)
+	if drop > len(digits) {
+		return digits[:0], minExp
+	}
+	keep := len(digits) - drop
+	roundUp := digits[keep] >= '5'
+	digits = digits[:keep]
+	if roundUp {
+		i := keep - 1
+		for i >= 0 && digits[i] == '9' {
+			digits[i] = '0'
+			i--
+		}
+		if i >= 0 {
+			digits[i]++
+		} else {
+			digits = append(digits, 0)
+			copy(digits[1:], digits)
+			digits[0] = '1'
+		}
+	}
+	return digits, minExp
+}
+
+// appendMoneyDigits rounds digits * 10^exp to decimalPlaces and appends it to dst
+// grouped as g says and with exactly max(decimalPlaces, 0) fractional digits
+func appendMoneyDigits(dst []byte, neg bool, digits []byte, exp int32, decimalPlaces int32, g Grouping) []byte {
+	digits, exp = roundDigits(digits, exp, -decimalPlaces)
+	return appendDigits(dst, neg, digits, exp, int(max(decimalPlaces, 0)), g)
+}
+
+// appendFixedDigits rounds digits * 10^exp to places and appends it to dst
+// with exactly max(places, 0) fractional digits
+func appendFixedDigits(dst []byte, neg bool, digits []byte, exp int32, places int32) []byte {
+	digits, exp = roundDigits(digits, exp, -places)
+	return appendDigits(dst, neg, digits, exp, int(max(places, 0)), Grouping{})
+}
+
+// appendDigits appends digits * 10^exp to dst in plain notation with frac fractional
+// digits, grouping the integer part and separating the fraction as g says. The caller
+// must have rounded the digits so that exp >= -frac.
+func appendDigits(dst []byte, neg bool, digits []byte, exp int32, frac int, g Grouping) []byte {
+	zero := true
+	for _, c := range digits {
+		if c != '0' {
+			zero = false
+			break
+		}
+	}
+	if neg && !zero {
+		dst = append(dst, '-')
+	}
+
+	n := len(digits)
+	point := n + int(exp)
+	if zero {
+		point = 0
+	}
+	digitAt := func(i int) byte {
+		if i >= 0 && i < n {
+			return digits[i]
+		}
+		return '0'
+	}
+
+	size := g.Size
+	if size <= 0 {
+		size = 3
+	}
+	if point <= 0 {
+		dst = append(dst, '0')
+	} else {
+		for i := 0; i < point; i++ {
+			if g.Separator != "" && i > 0 && (point-i)%size == 0 {
+				dst = append(dst, g.Separator...)
+			}
+			dst = append(dst, digitAt(i))
+		}
+	}
+
+	if frac > 0 {
+		if g.Decimal != "" {
+			dst = append(dst, g.Decimal...)
+		} else {
+			dst = append(dst, '.')
+		}
+		for j := 0; j < frac; j++ {
+			dst = append(dst, digitAt(point+j))
+		}
+	}
+	return dst
+}
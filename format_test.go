@@ -0,0 +1,275 @@
+package mathx
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAppendMoney(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        float64
+		decimalPlaces int32
+		expected      string
+	}{
+		{"thousands separator", 1234567.89, 2, "1,234,567.89"},
+		{"no thousands separator", 123.45, 2, "123.45"},
+		{"integer", 1000, 0, "1,000"},
+		{"negative", -1234567.89, 2, "-1,234,567.89"},
+		{"negative three digits", -114.99, 2, "-114.99"},
+		{"padded decimals", 12.5, 2, "12.50"},
+		{"round half up", 2.345, 2, "2.35"},
+		{"round half away from zero", -2.345, 2, "-2.35"},
+		{"carry into new digit", 999.995, 2, "1,000.00"},
+		{"small value", 0.001, 2, "0.00"},
+		{"small negative value", -0.001, 2, "0.00"},
+		{"leading fraction zeros", 0.05, 3, "0.050"},
+		{"negative places", 1234.5, -2, "1,200"},
+		{"large number", 1.15e10, 2, "11,500,000,000.00"},
+		{"zero", 0, 2, "0.00"},
+		{"NaN", math.NaN(), 2, "NaN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := []byte("$")
+			got := string(AppendMoney(dst, tt.amount, tt.decimalPlaces))
+			if got != "$"+tt.expected {
+				t.Errorf("AppendMoney() = %v, want $%v", got, tt.expected)
+			}
+			if tt.amount == tt.amount {
+				if got := NewResult(tt.amount).FormatMoney(tt.decimalPlaces); got != tt.expected {
+					t.Errorf("Result.FormatMoney() = %v, want %v", got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestAppendMoneyInt(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        int64
+		decimalPlaces int32
+		expected      string
+	}{
+		{"thousands separator", 1234567, 2, "1,234,567.00"},
+		{"integer", 1000, 0, "1,000"},
+		{"negative", -123456, 2, "-123,456.00"},
+		{"three places", 5, 3, "5.000"},
+		{"min int64", math.MinInt64, 0, "-9,223,372,036,854,775,808"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(AppendMoneyInt(nil, tt.amount, tt.decimalPlaces)); got != tt.expected {
+				t.Errorf("AppendMoneyInt() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatMoneyGrouped(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		places   int32
+		grouping Grouping
+		expected string
+	}{
+		{"comma", 1234567.89, 2, GroupComma, "1,234,567.89"},
+		{"period", 1234567.89, 2, GroupPeriod, "1.234.567,89"},
+		{"space", -1234567.89, 2, GroupSpace, "-1 234 567.89"},
+		{"apostrophe", 1234567.89, 2, GroupApostrophe, "1'234'567.89"},
+		{"underscore", 1234567, 0, GroupUnderscore, "1_234_567"},
+		{"no grouping", 1234567.89, 2, Grouping{}, "1234567.89"},
+		{"group of four", 123456789, 0, Grouping{Separator: ",", Size: 4}, "1,2345,6789"},
+		{"group of two", 1234.5, 1, Grouping{Separator: " ", Size: 2}, "12 34.5"},
+		{"short", 123, 2, GroupPeriod, "123,00"},
+		{"period without decimal comma", 1234.5, 1, Grouping{Separator: "."}, "1.234.5"},
+		{"space with decimal comma", -1234567.89, 2, Grouping{Separator: " ", Decimal: ","}, "-1 234 567,89"},
+		{"decimal only", 1234.5, 2, Grouping{Decimal: ","}, "1234,50"},
+		{"narrow no-break space", 1234567.5, 1, Grouping{Separator: "\u202f", Decimal: ","}, "1\u202f234\u202f567,5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatMoneyGrouped(tt.amount, tt.places, tt.grouping); got != tt.expected {
+				t.Errorf("FormatMoneyGrouped() = %v, want %v", got, tt.expected)
+			}
+			if tt.amount == math.Trunc(tt.amount) {
+				if got := FormatMoneyIntGrouped(int64(tt.amount), tt.places, tt.grouping); got != tt.expected {
+					t.Errorf("FormatMoneyIntGrouped() = %v, want %v", got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestAppendMoney_matchesDecimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		amount := (rng.Float64() - 0.5) * math.Pow(10, float64(rng.Intn(14)))
+		places := int32(rng.Intn(7) - 2)
+		fixed := max(places, 0)
+		want := decimal.NewFromFloat(amount).Round(places).StringFixed(fixed)
+		got := string(AppendMoney(nil, amount, places))
+		if stripCommas(got) != want && !(want[0] == '-' && got == want[1:]) {
+			t.Fatalf("AppendMoney(%v, %d) = %v, want %v", amount, places, got, want)
+		}
+	}
+}
+
+func stripCommas(s string) string {
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != ',' {
+			out = append(out, s[i])
+		}
+	}
+	return string(out)
+}
+
+func TestAppendString(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		expected string
+	}{
+		{"decimal", 3.14159, "3.14159"},
+		{"negative", -0.5, "-0.5"},
+		{"integer", 42, "42"},
+		{"zero", 0, "0"},
+		{"large", 1e21, "1000000000000000000000"},
+		{"small", 1e-7, "0.0000001"},
+		{"inf", math.Inf(1), "+Inf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(AppendString([]byte("v="), tt.value)); got != "v="+tt.expected {
+				t.Errorf("AppendString() = %v, want v=%v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAppendFixed(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		places   int32
+		expected string
+	}{
+		{"round", 3.14159, 2, "3.14"},
+		{"pad", 1.5, 3, "1.500"},
+		{"half away from zero", -2.5, 0, "-3"},
+		{"negative places", 1250, -2, "1300"},
+		{"small negative", -0.004, 2, "0.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(AppendFixed(nil, tt.value, tt.places)); got != tt.expected {
+				t.Errorf("AppendFixed() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAppend_matchesDecimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 10000; i++ {
+		value := (rng.Float64() - 0.5) * math.Pow(10, float64(rng.Intn(40)-20))
+		places := int32(rng.Intn(9) - 2)
+		d := decimal.NewFromFloat(value)
+
+		if got, want := string(AppendString(nil, value)), d.String(); got != want {
+			t.Fatalf("AppendString(%v) = %v, want %v", value, got, want)
+		}
+		if got, want := string(AppendFixed(nil, value, places)), d.StringFixed(places); got != want {
+			t.Fatalf("AppendFixed(%v, %d) = %v, want %v", value, places, got, want)
+		}
+
+		r := NewResult(value).Mul(decimal.New(1, -3))
+		if got, want := string(r.AppendString(nil)), r.String(); got != want {
+			t.Fatalf("Result.AppendString(%v) = %v, want %v", r, got, want)
+		}
+		if got, want := string(r.AppendFixed(nil, places)), r.ToStringFixed(places); got != want {
+			t.Fatalf("Result.AppendFixed(%v, %d) = %v, want %v", r, places, got, want)
+		}
+	}
+}
+
+func TestWriteMoneyFixed(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteMoney(&sb, -1234567.891, 2); err != nil {
+		t.Fatalf("WriteMoney() error = %v", err)
+	}
+	sb.WriteByte(';')
+	if err := WriteFixed(&sb, 3.14159, 3); err != nil {
+		t.Fatalf("WriteFixed() error = %v", err)
+	}
+	if got, want := sb.String(), "-1,234,567.89;3.142"; got != want {
+		t.Errorf("written = %q, want %q", got, want)
+	}
+
+	if err := WriteMoney(errWriter{}, 1, 2); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("WriteMoney() error = %v, want io.ErrShortWrite", err)
+	}
+	if err := WriteFixed(errWriter{}, 1, 2); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("WriteFixed() error = %v, want io.ErrShortWrite", err)
+	}
+}
+
+// errWriter is an io.Writer that always fails
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, io.ErrShortWrite }
+
+func TestWriters_noAllocs(t *testing.T) {
+	checks := map[string]func(){
+		"WriteMoney": func() { WriteMoney(io.Discard, 1234567.89, 2) },
+		"WriteFixed": func() { WriteFixed(io.Discard, 3.14159, 2) },
+	}
+	for name, fn := range checks {
+		if allocs := testing.AllocsPerRun(100, fn); allocs != 0 {
+			t.Errorf("%s allocates %v times per run, want 0", name, allocs)
+		}
+	}
+}
+
+func TestFormatting_noNegativeZero(t *testing.T) {
+	de, _ := LocaleMoneyFormat("de-DE")
+	formatters := map[string]func(float64) string{
+		"ToString":         ToString,
+		"ToStringFixed":    func(v float64) string { return ToStringFixed(v, 2) },
+		"ToStringBank":     func(v float64) string { return ToStringBank(v, 2) },
+		"ToStringPadded":   func(v float64) string { return ToStringPadded(v, 2, 6, '0') },
+		"FormatMoney":      func(v float64) string { return FormatMoney(v, 2) },
+		"FormatMoneyGroup": func(v float64) string { return FormatMoneyGrouped(v, 0, GroupPeriod) },
+		"FormatCurrency":   func(v float64) string { return FormatCurrency(v, 2) },
+		"FormatPercent":    func(v float64) string { return FormatPercent(v, 1) },
+		"MoneyFormat":      de.Format,
+		"RemoveZerosFixed": func(v float64) string { return RemoveTrailingZerosFixed(v, 2) },
+		"ExactString":      ExactString,
+		"Result.Fixed":     func(v float64) string { return NewResult(v).ToStringFixed(2) },
+		"Result.Money":     func(v float64) string { return NewResult(v).FormatMoney(2) },
+		"Result.Round":     func(v float64) string { return Round(v, 2).String() },
+	}
+
+	for name, format := range formatters {
+		for _, v := range []float64{math.Copysign(0, -1), -0.000001, -0.004} {
+			// a minus sign is only allowed in front of a non-zero digit
+			if got := format(v); strings.HasPrefix(got, "-") && !strings.ContainsAny(got, "123456789") {
+				t.Errorf("%s(%v) = %q, want no negative zero", name, v, got)
+			}
+		}
+	}
+}
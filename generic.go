@@ -0,0 +1,121 @@
+package mathx
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/shopspring/decimal"
+	"golang.org/x/exp/constraints"
+)
+
+// Number is the set of types accepted by the generic entry points.
+// Strings are parsed as exact decimal literals.
+type Number interface {
+	constraints.Integer | constraints.Float | ~string | decimal.Decimal
+}
+
+// NewResultFrom creates a new Result from any supported number type without
+// converting it to float64 first
+func NewResultFrom[T Number](value T) (Result, error) {
+	d, err := toDecimal(value)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{v: d}, nil
+}
+
+// AddT adds two values of any supported number type and returns a Result
+func AddT[T Number](a, b T) (Result, error) {
+	da, db, err := toDecimals(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	return AddSafe(da, db), nil
+}
+
+// SubT subtracts two values of any supported number type and returns a Result
+func SubT[T Number](a, b T) (Result, error) {
+	da, db, err := toDecimals(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	return SubSafe(da, db), nil
+}
+
+// MulT multiplies two values of any supported number type and returns a Result
+func MulT[T Number](a, b T) (Result, error) {
+	da, db, err := toDecimals(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	return MulSafe(da, db), nil
+}
+
+// DivT divides two values of any supported number type with specified precision.
+// It returns ErrDivisionByZero if b is zero.
+func DivT[T Number](a, b T, precision int32) (Result, error) {
+	da, db, err := toDecimals(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	if db.IsZero() {
+		return Result{}, ErrDivisionByZero
+	}
+	return DivSafe(da, db, precision), nil
+}
+
+// toDecimals converts two values to decimals
+func toDecimals[T Number](a, b T) (decimal.Decimal, decimal.Decimal, error) {
+	da, err := toDecimal(a)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, err
+	}
+	db, err := toDecimal(b)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, err
+	}
+	return da, db, nil
+}
+
+// toDecimal converts a value of any supported number type to a decimal
+func toDecimal[T Number](value T) (decimal.Decimal, error) {
+	switch v := any(value).(type) {
+	case decimal.Decimal:
+		return v, nil
+	case int:
+		return decimal.NewFromInt(int64(v)), nil
+	case int64:
+		return decimal.NewFromInt(v), nil
+	case float64:
+		return floatToDecimal(v)
+	case string:
+		return decimal.NewFromString(v)
+	}
+
+	// other basic types and named types such as type Cents int64
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return decimal.NewFromInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return decimal.NewFromUint64(rv.Uint()), nil
+	case reflect.Float32:
+		f := float32(rv.Float())
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return decimal.Decimal{}, ErrNotFinite
+		}
+		return decimal.NewFromFloat32(f), nil
+	case reflect.Float64:
+		return floatToDecimal(rv.Float())
+	default:
+		return decimal.NewFromString(rv.String())
+	}
+}
+
+// floatToDecimal converts a float64 to a decimal, rejecting NaN and infinities
+func floatToDecimal(f float64) (decimal.Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return decimal.Decimal{}, ErrNotFinite
+	}
+	return decimal.NewFromFloat(f), nil
+}
@@ -0,0 +1,81 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+type cents int64
+
+type amount string
+
+func TestNewResultFrom(t *testing.T) {
+	check := func(name string, got Result, err error, expected string) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if got.String() != expected {
+			t.Errorf("%s = %v, want %v", name, got, expected)
+		}
+	}
+
+	r, err := NewResultFrom(42)
+	check("int", r, err, "42")
+	r, err = NewResultFrom(int64(-7))
+	check("int64", r, err, "-7")
+	r, err = NewResultFrom(uint64(math.MaxUint64))
+	check("uint64", r, err, "18446744073709551615")
+	r, err = NewResultFrom(float32(0.1))
+	check("float32", r, err, "0.1")
+	r, err = NewResultFrom(2.5)
+	check("float64", r, err, "2.5")
+	r, err = NewResultFrom("12345678901234567890.123")
+	check("string", r, err, "12345678901234567890.123")
+	r, err = NewResultFrom(decimal.RequireFromString("1.23"))
+	check("decimal", r, err, "1.23")
+	r, err = NewResultFrom(cents(150))
+	check("named int", r, err, "150")
+	r, err = NewResultFrom(amount("9.99"))
+	check("named string", r, err, "9.99")
+}
+
+func TestNewResultFrom_errors(t *testing.T) {
+	if _, err := NewResultFrom("not a number"); err == nil {
+		t.Error("NewResultFrom() expected error for invalid string")
+	}
+	if _, err := NewResultFrom(math.NaN()); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("NewResultFrom(NaN) error = %v, want ErrNotFinite", err)
+	}
+	if _, err := NewResultFrom(float32(math.Inf(1))); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("NewResultFrom(+Inf) error = %v, want ErrNotFinite", err)
+	}
+}
+
+func TestGenericArithmetic(t *testing.T) {
+	r, err := AddT(float32(0.1), float32(0.2))
+	if err != nil || r.String() != "0.3" {
+		t.Errorf("AddT() = %v, %v, want 0.3", r, err)
+	}
+	r, err = SubT(10, 3)
+	if err != nil || r.String() != "7" {
+		t.Errorf("SubT() = %v, %v, want 7", r, err)
+	}
+	r, err = MulT("1.5", "2")
+	if err != nil || r.String() != "3" {
+		t.Errorf("MulT() = %v, %v, want 3", r, err)
+	}
+	r, err = DivT(cents(100), cents(3), 2)
+	if err != nil || r.String() != "33.33" {
+		t.Errorf("DivT() = %v, %v, want 33.33", r, err)
+	}
+	if _, err = DivT(1, 0, 2); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("DivT() error = %v, want ErrDivisionByZero", err)
+	}
+	if _, err = AddT("1", "x"); err == nil {
+		t.Error("AddT() expected error for invalid operand")
+	}
+}
@@ -0,0 +1,44 @@
+package mathx
+
+import "math"
+
+// EarthRadius is the mean Earth radius in meters used by Haversine
+const EarthRadius = 6371000.0
+
+// Haversine returns the great-circle distance in meters between two points given
+// by latitude and longitude in degrees, assuming a spherical Earth of radius EarthRadius
+func Haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1 := degToRad(lat1)
+	phi2 := degToRad(lat2)
+	dPhi := degToRad(lat2 - lat1)
+	dLambda := degToRad(lon2 - lon1)
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	return EarthRadius * c
+}
+
+// HaversineKm returns the great-circle distance in kilometers between two points
+// given by latitude and longitude in degrees
+func HaversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	return Haversine(lat1, lon1, lat2, lon2) / 1000
+}
+
+// InitialBearing returns the initial bearing in degrees [0, 360) of the great-circle
+// path from the first point to the second, measured clockwise from true north
+func InitialBearing(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1 := degToRad(lat1)
+	phi2 := degToRad(lat2)
+	dLambda := degToRad(lon2 - lon1)
+
+	y := math.Sin(dLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda)
+	bearing := math.Atan2(y, x) * 180 / math.Pi
+	return math.Mod(bearing+360, 360)
+}
+
+// degToRad converts degrees to radians
+func degToRad(deg float64) float64 {
+	return deg * math.Pi / 180
+}
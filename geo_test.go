@@ -0,0 +1,58 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHaversine(t *testing.T) {
+	tests := []struct {
+		name       string
+		lat1, lon1 float64
+		lat2, lon2 float64
+		expectedKm float64
+		toleranceK float64
+	}{
+		{"same point", 51.5, -0.12, 51.5, -0.12, 0, 1e-9},
+		{"London to Paris", 51.5074, -0.1278, 48.8566, 2.3522, 343.56, 0.5},
+		{"New York to Los Angeles", 40.7128, -74.0060, 34.0522, -118.2437, 3935.75, 1},
+		{"quarter meridian", 0, 0, 90, 0, math.Pi * EarthRadius / 2000, 1e-6},
+		{"antipodal", 0, 0, 0, 180, math.Pi * EarthRadius / 1000, 1e-6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			km := HaversineKm(tt.lat1, tt.lon1, tt.lat2, tt.lon2)
+			if math.Abs(km-tt.expectedKm) > tt.toleranceK {
+				t.Errorf("HaversineKm() = %v, want %v", km, tt.expectedKm)
+			}
+			if m := Haversine(tt.lat1, tt.lon1, tt.lat2, tt.lon2); math.Abs(m-km*1000) > 1e-6 {
+				t.Errorf("Haversine() = %v, want %v", m, km*1000)
+			}
+		})
+	}
+}
+
+func TestInitialBearing(t *testing.T) {
+	tests := []struct {
+		name       string
+		lat1, lon1 float64
+		lat2, lon2 float64
+		expected   float64
+		tolerance  float64
+	}{
+		{"north", 0, 0, 10, 0, 0, 1e-9},
+		{"east", 0, 0, 0, 10, 90, 1e-9},
+		{"south", 10, 0, 0, 0, 180, 1e-9},
+		{"west", 0, 10, 0, 0, 270, 1e-9},
+		{"London to Paris", 51.5074, -0.1278, 48.8566, 2.3522, 148.1, 0.1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InitialBearing(tt.lat1, tt.lon1, tt.lat2, tt.lon2); math.Abs(got-tt.expected) > tt.tolerance {
+				t.Errorf("InitialBearing() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
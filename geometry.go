@@ -0,0 +1,86 @@
+package mathx
+
+import "math"
+
+// Point is a point in the 2D plane
+type Point struct {
+	X, Y float64
+}
+
+// Distance returns the Euclidean distance between (x1, y1) and (x2, y2)
+func Distance(x1, y1, x2, y2 float64) float64 {
+	return math.Hypot(x2-x1, y2-y1)
+}
+
+// PolygonArea returns the area of a simple polygon given its vertices in order,
+// computed with the shoelace formula. The polygon is closed implicitly.
+func PolygonArea(points []Point) float64 {
+	return math.Abs(signedArea(points))
+}
+
+// Centroid returns the centroid of a simple polygon given its vertices in order.
+// For degenerate polygons with zero area it returns the mean of the vertices.
+func Centroid(points []Point) Point {
+	if len(points) == 0 {
+		return Point{}
+	}
+	area := signedArea(points)
+	if area == 0 {
+		var c Point
+		for _, p := range points {
+			c.X += p.X
+			c.Y += p.Y
+		}
+		n := float64(len(points))
+		return Point{X: c.X / n, Y: c.Y / n}
+	}
+
+	var cx, cy float64
+	for i, p := range points {
+		q := points[(i+1)%len(points)]
+		cross := p.X*q.Y - q.X*p.Y
+		cx += (p.X + q.X) * cross
+		cy += (p.Y + q.Y) * cross
+	}
+	return Point{X: cx / (6 * area), Y: cy / (6 * area)}
+}
+
+// PointInPolygon reports whether p lies inside the polygon given by its vertices
+// in order, using ray casting. Points on an edge are considered inside.
+func PointInPolygon(p Point, polygon []Point) bool {
+	inside := false
+	for i, a := range polygon {
+		b := polygon[(i+1)%len(polygon)]
+		if onSegment(p, a, b) {
+			return true
+		}
+		if (a.Y > p.Y) != (b.Y > p.Y) {
+			x := a.X + (p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+			if p.X < x {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// signedArea returns the signed shoelace area, positive for counter-clockwise vertices
+func signedArea(points []Point) float64 {
+	var sum float64
+	for i, p := range points {
+		q := points[(i+1)%len(points)]
+		sum += p.X*q.Y - q.X*p.Y
+	}
+	return sum / 2
+}
+
+// onSegment reports whether p lies on the segment from a to b
+func onSegment(p, a, b Point) bool {
+	cross := (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+	scale := max(math.Abs(b.X-a.X), math.Abs(b.Y-a.Y), 1)
+	if math.Abs(cross) > 1e-12*scale*scale {
+		return false
+	}
+	return p.X >= min(a.X, b.X) && p.X <= max(a.X, b.X) &&
+		p.Y >= min(a.Y, b.Y) && p.Y <= max(a.Y, b.Y)
+}
@@ -0,0 +1,102 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name           string
+		x1, y1, x2, y2 float64
+		expected       float64
+	}{
+		{"3-4-5 triangle", 0, 0, 3, 4, 5},
+		{"same point", 1, 1, 1, 1, 0},
+		{"negative coordinates", -1, -1, 2, 3, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distance(tt.x1, tt.y1, tt.x2, tt.y2); math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("Distance() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPolygonArea(t *testing.T) {
+	square := []Point{{0, 0}, {4, 0}, {4, 4}, {0, 4}}
+	tests := []struct {
+		name     string
+		points   []Point
+		expected float64
+	}{
+		{"square", square, 16},
+		{"clockwise square", []Point{{0, 0}, {0, 4}, {4, 4}, {4, 0}}, 16},
+		{"triangle", []Point{{0, 0}, {4, 0}, {0, 3}}, 6},
+		{"L shape", []Point{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}, 3},
+		{"degenerate", []Point{{0, 0}, {1, 1}}, 0},
+		{"empty", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PolygonArea(tt.points); math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("PolygonArea() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCentroid(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   []Point
+		expected Point
+	}{
+		{"square", []Point{{0, 0}, {4, 0}, {4, 4}, {0, 4}}, Point{2, 2}},
+		{"triangle", []Point{{0, 0}, {6, 0}, {0, 3}}, Point{2, 1}},
+		{"L shape", []Point{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}, Point{5.0 / 6, 5.0 / 6}},
+		{"degenerate", []Point{{0, 0}, {2, 2}}, Point{1, 1}},
+		{"empty", nil, Point{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Centroid(tt.points)
+			if math.Abs(got.X-tt.expected.X) > 1e-12 || math.Abs(got.Y-tt.expected.Y) > 1e-12 {
+				t.Errorf("Centroid() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPointInPolygon(t *testing.T) {
+	lShape := []Point{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}
+	tests := []struct {
+		name     string
+		p        Point
+		expected bool
+	}{
+		{"inside", Point{0.5, 0.5}, true},
+		{"inside upper arm", Point{0.5, 1.5}, true},
+		{"in notch", Point{1.5, 1.5}, false},
+		{"outside", Point{3, 3}, false},
+		{"on edge", Point{1, 0}, true},
+		{"on vertex", Point{2, 1}, true},
+		{"left of polygon", Point{-1, 0.5}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PointInPolygon(tt.p, lShape); got != tt.expected {
+				t.Errorf("PointInPolygon(%v) = %v, want %v", tt.p, got, tt.expected)
+			}
+		})
+	}
+
+	if PointInPolygon(Point{0, 0}, nil) {
+		t.Error("PointInPolygon() with empty polygon = true, want false")
+	}
+}
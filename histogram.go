@@ -0,0 +1,68 @@
+package mathx
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// DecimalHistogram counts observations in buckets with fixed upper bounds, like a
+// Prometheus histogram, and keeps the exact sum of all observations.
+// It is safe for concurrent use.
+type DecimalHistogram struct {
+	mu     sync.Mutex
+	bounds []decimal.Decimal
+	counts []uint64 // per bucket, non-cumulative; the last entry is the +Inf bucket
+	sum    decimal.Decimal
+	count  uint64
+}
+
+// HistogramBucket is a cumulative bucket: Count observations were <= UpperBound
+type HistogramBucket struct {
+	UpperBound decimal.Decimal
+	Count      uint64
+}
+
+// HistogramSnapshot is an export of a DecimalHistogram in the Prometheus layout.
+// Buckets are cumulative and sorted by bound; the implicit +Inf bucket equals Count.
+type HistogramSnapshot struct {
+	Buckets []HistogramBucket
+	Sum     decimal.Decimal
+	Count   uint64
+}
+
+// NewDecimalHistogram creates a histogram with the given bucket upper bounds.
+// The bounds are sorted and duplicates removed; a +Inf bucket is always implied.
+func NewDecimalHistogram(bounds ...decimal.Decimal) *DecimalHistogram {
+	b := sortedDecimals(bounds)
+	b = slices.CompactFunc(b, decimal.Decimal.Equal)
+	return &DecimalHistogram{bounds: b, counts: make([]uint64, len(b)+1)}
+}
+
+// Observe records v in the first bucket whose upper bound is >= v
+func (h *DecimalHistogram) Observe(v decimal.Decimal) {
+	i, _ := slices.BinarySearchFunc(h.bounds, v, decimal.Decimal.Cmp)
+	h.mu.Lock()
+	h.counts[i]++
+	h.sum = h.sum.Add(v)
+	h.count++
+	h.mu.Unlock()
+}
+
+// Snapshot returns the cumulative bucket counts together with the sum and count
+func (h *DecimalHistogram) Snapshot() HistogramSnapshot {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := HistogramSnapshot{
+		Buckets: make([]HistogramBucket, len(h.bounds)),
+		Sum:     h.sum,
+		Count:   h.count,
+	}
+	var cumulative uint64
+	for i, b := range h.bounds {
+		cumulative += h.counts[i]
+		s.Buckets[i] = HistogramBucket{UpperBound: b, Count: cumulative}
+	}
+	return s
+}
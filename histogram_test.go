@@ -0,0 +1,69 @@
+package mathx
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDecimalHistogram(t *testing.T) {
+	h := NewDecimalHistogram(decimals("1", "0.5", "0.1", "0.5")...)
+	for _, v := range decimals("0.05", "0.1", "0.2", "0.5", "0.75", "2", "-1") {
+		h.Observe(v)
+	}
+
+	s := h.Snapshot()
+	want := []struct {
+		bound string
+		count uint64
+	}{
+		{"0.1", 3},
+		{"0.5", 5},
+		{"1", 6},
+	}
+	if len(s.Buckets) != len(want) {
+		t.Fatalf("Snapshot() has %d buckets, want %d", len(s.Buckets), len(want))
+	}
+	for i, w := range want {
+		b := s.Buckets[i]
+		if b.UpperBound.String() != w.bound || b.Count != w.count {
+			t.Errorf("bucket %d = {%v %d}, want {%v %d}", i, b.UpperBound, b.Count, w.bound, w.count)
+		}
+	}
+	if s.Count != 7 || s.Sum.String() != "2.6" {
+		t.Errorf("Snapshot() count/sum = %d/%v, want 7/2.6", s.Count, s.Sum)
+	}
+}
+
+func TestDecimalHistogram_noBounds(t *testing.T) {
+	h := NewDecimalHistogram()
+	h.Observe(decimal.NewFromInt(5))
+	s := h.Snapshot()
+	if len(s.Buckets) != 0 || s.Count != 1 || s.Sum.String() != "5" {
+		t.Errorf("Snapshot() = %+v", s)
+	}
+}
+
+func TestDecimalHistogram_concurrent(t *testing.T) {
+	h := NewDecimalHistogram(decimals("10", "100")...)
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				h.Observe(decimal.NewFromInt(int64(i)))
+			}
+		}()
+	}
+	wg.Wait()
+
+	s := h.Snapshot()
+	if s.Count != 1000 || s.Buckets[0].Count != 44 || s.Buckets[1].Count != 404 {
+		t.Errorf("Snapshot() = %+v", s)
+	}
+	if s.Sum.String() != "124500" {
+		t.Errorf("Sum = %v, want 124500", s.Sum)
+	}
+}
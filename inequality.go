@@ -0,0 +1,97 @@
+package mathx
+
+import (
+	"math"
+	"slices"
+)
+
+// Gini returns the Gini coefficient of values, from 0 when all values are equal to
+// (n-1)/n when a single value holds the whole total. NaN values are dropped, so they
+// do not count towards n, and negative values count as zero. It returns 0 if there
+// are no values or their total is zero.
+func Gini(values []float64) float64 {
+	sorted := sortedShares(values)
+	n := float64(len(sorted))
+	var total, weighted compensatedSum
+	for i, v := range sorted {
+		total.Add(v)
+		weighted.Add(float64(i+1) * v)
+	}
+	if n == 0 || total.Value() == 0 {
+		return 0
+	}
+	return 2*weighted.Value()/(n*total.Value()) - (n+1)/n
+}
+
+// LorenzCurve returns the points of the Lorenz curve of values: x holds the cumulative
+// share of the population (0, 1/n, ..., 1) and y the cumulative share of the total held
+// by the poorest x. Values are treated as in Gini; a zero total yields the line of
+// equality. It returns nil slices if there are no values.
+func LorenzCurve(values []float64) (x, y []float64) {
+	sorted := sortedShares(values)
+	n := len(sorted)
+	if n == 0 {
+		return nil, nil
+	}
+	var total compensatedSum
+	for _, v := range sorted {
+		total.Add(v)
+	}
+
+	x = make([]float64, n+1)
+	y = make([]float64, n+1)
+	var cum compensatedSum
+	for i, v := range sorted {
+		x[i+1] = float64(i+1) / float64(n)
+		cum.Add(v)
+		if total.Value() == 0 {
+			y[i+1] = x[i+1]
+		} else {
+			y[i+1] = cum.Value() / total.Value()
+		}
+	}
+	return x, y
+}
+
+// HHI returns the Herfindahl-Hirschman index of market shares given in percent: the
+// sum of their squares, from near 0 for a fragmented market to 10000 for a monopoly.
+// Antitrust guidelines commonly treat values above 2500 as highly concentrated.
+func HHI(shares []float64) float64 {
+	var sum compensatedSum
+	for _, s := range shares {
+		sum.Add(s * s)
+	}
+	return sum.Value()
+}
+
+// HHIFromValues returns the HHI of raw values such as revenues, computing each value's
+// percent share of the total first. Values are treated as in Gini; it returns 0 if
+// their total is zero.
+func HHIFromValues(values []float64) float64 {
+	sorted := sortedShares(values)
+	var total compensatedSum
+	for _, v := range sorted {
+		total.Add(v)
+	}
+	if total.Value() == 0 {
+		return 0
+	}
+	shares := make([]float64, len(sorted))
+	for i, v := range sorted {
+		shares[i] = 100 * v / total.Value()
+	}
+	return HHI(shares)
+}
+
+// sortedShares returns values sorted ascending without NaNs and with negative
+// values replaced by zero
+func sortedShares(values []float64) []float64 {
+	sorted := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sorted = append(sorted, max(v, 0))
+		}
+	}
+	slices.Sort(sorted)
+	return sorted
+}
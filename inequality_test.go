@@ -0,0 +1,94 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGini(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{"equal", []float64{5, 5, 5, 5}, 0},
+		{"one holds all", []float64{0, 0, 0, 10}, 0.75},
+		{"unsorted", []float64{3, 1, 2}, 2.0 / 9},
+		{"negative as zero", []float64{-5, 10}, 0.5},
+		{"NaN ignored", []float64{1, math.NaN(), 1}, 0},
+		{"zero total", []float64{0, 0}, 0},
+		{"empty", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Gini(tt.values); math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("Gini() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLorenzCurve(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		x, y   []float64
+	}{
+		{"unsorted", []float64{3, 1, 4, 2}, []float64{0, 0.25, 0.5, 0.75, 1}, []float64{0, 0.1, 0.3, 0.6, 1}},
+		{"zero total", []float64{0, 0}, []float64{0, 0.5, 1}, []float64{0, 0.5, 1}},
+		{"empty", nil, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y := LorenzCurve(tt.values)
+			if !floatsEqual(x, tt.x, 1e-12) || !floatsEqual(y, tt.y, 1e-12) {
+				t.Errorf("LorenzCurve() = %v, %v, want %v, %v", x, y, tt.x, tt.y)
+			}
+		})
+	}
+}
+
+func TestHHI(t *testing.T) {
+	tests := []struct {
+		name     string
+		shares   []float64
+		expected float64
+	}{
+		{"monopoly", []float64{100}, 10000},
+		{"duopoly", []float64{50, 50}, 5000},
+		{"mixed", []float64{30, 30, 20, 20}, 2600},
+		{"empty", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HHI(tt.shares); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("HHI() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHHIFromValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{"revenues", []float64{300, 300, 200, 200}, 2600},
+		{"single", []float64{42}, 10000},
+		{"ten equal", []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, 1000},
+		{"negative as zero", []float64{-5, 10, 10}, 5000},
+		{"zero total", []float64{0, 0}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HHIFromValues(tt.values); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("HHIFromValues() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package mathx
+
+import "sort"
+
+// Bilinear interpolates the value at (x, y) on a rectilinear grid, where grid[i][j]
+// is the value at (xs[i], ys[j]); e.g. a rate table with terms as rows and amounts as
+// columns. xs and ys must be strictly ascending with at least two points each.
+// Points outside the grid are clamped to its edges. The arithmetic is plain float64.
+// It returns ErrDimensionMismatch if grid does not have len(xs) rows of len(ys) values,
+// ErrInsufficientData if an axis has fewer than two points and ErrDomain if an axis
+// is not strictly ascending.
+func Bilinear(x, y float64, grid [][]float64, xs, ys []float64) (float64, error) {
+	if len(xs) < 2 || len(ys) < 2 {
+		return 0, ErrInsufficientData
+	}
+	if len(grid) != len(xs) {
+		return 0, ErrDimensionMismatch
+	}
+	for _, row := range grid {
+		if len(row) != len(ys) {
+			return 0, ErrDimensionMismatch
+		}
+	}
+	if !strictlyAscending(xs) || !strictlyAscending(ys) {
+		return 0, ErrDomain
+	}
+
+	i, tx := gridCell(xs, x)
+	j, ty := gridCell(ys, y)
+	lo := lerpFloat(grid[i][j], grid[i][j+1], ty)
+	hi := lerpFloat(grid[i+1][j], grid[i+1][j+1], ty)
+	return lerpFloat(lo, hi, tx), nil
+}
+
+// gridCell returns the index i of the cell [axis[i], axis[i+1]] containing v,
+// clamped to the axis, and the position of v within the cell in [0, 1]
+func gridCell(axis []float64, v float64) (int, float64) {
+	v = Clamp(v, axis[0], axis[len(axis)-1])
+	i := sort.SearchFloat64s(axis, v) - 1
+	i = Clamp(i, 0, len(axis)-2)
+	return i, (v - axis[i]) / (axis[i+1] - axis[i])
+}
+
+// lerpFloat is Lerp in plain float64 arithmetic, which tolerates NaN and Inf
+func lerpFloat(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// strictlyAscending reports whether every value is greater than the one before
+func strictlyAscending(values []float64) bool {
+	for i := 1; i < len(values); i++ {
+		if !(values[i] > values[i-1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBilinear(t *testing.T) {
+	// rates by term (rows) and amount (columns)
+	terms := []float64{12, 24, 36}
+	amounts := []float64{1000, 5000}
+	grid := [][]float64{
+		{5.0, 4.0},
+		{6.0, 5.0},
+		{7.0, 5.5},
+	}
+
+	tests := []struct {
+		name     string
+		x, y     float64
+		expected float64
+	}{
+		{"grid point", 24, 5000, 5},
+		{"between terms", 18, 1000, 5.5},
+		{"between amounts", 12, 3000, 4.5},
+		{"inside cell", 30, 2000, 6.1875},
+		{"clamped below", 0, 0, 5},
+		{"clamped above", 48, 10000, 5.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Bilinear(tt.x, tt.y, grid, terms, amounts)
+			if err != nil {
+				t.Fatalf("Bilinear() error = %v", err)
+			}
+			if math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("Bilinear(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBilinear_errors(t *testing.T) {
+	grid := [][]float64{{1, 2}, {3, 4}}
+	tests := []struct {
+		name   string
+		grid   [][]float64
+		xs, ys []float64
+		err    error
+	}{
+		{"short axis", [][]float64{{1, 2}}, []float64{0}, []float64{0, 1}, ErrInsufficientData},
+		{"row count", grid, []float64{0, 1, 2}, []float64{0, 1}, ErrDimensionMismatch},
+		{"ragged row", [][]float64{{1, 2}, {3}}, []float64{0, 1}, []float64{0, 1}, ErrDimensionMismatch},
+		{"descending axis", grid, []float64{1, 0}, []float64{0, 1}, ErrDomain},
+		{"repeated point", grid, []float64{0, 1}, []float64{1, 1}, ErrDomain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Bilinear(0.5, 0.5, tt.grid, tt.xs, tt.ys); !errors.Is(err, tt.err) {
+				t.Errorf("Bilinear() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package mathx
+
+import "math"
+
+// NegLog10 returns -log10(value), the "p" function of chemistry, e.g. a hydrogen ion
+// concentration of 1e-7 mol/L has pH NegLog10(1e-7) == 7. A value of 0 gives +Inf and a
+// negative value gives NaN.
+func NegLog10(value float64) float64 {
+	return -math.Log10(value)
+}
+
+// FromNegLog10 is the inverse of NegLog10: 10^-p
+func FromNegLog10(p float64) float64 {
+	return math.Pow(10, -p)
+}
+
+// LogIndex returns scale * log10(value / reference), the general form of logarithmic
+// magnitude scales: scale 1 gives a Richter-style magnitude, 10 gives decibels of power
+// and -2.5 gives astronomical magnitude. The result is 0 when value equals reference.
+func LogIndex(value, reference, scale float64) float64 {
+	return scale * math.Log10(value/reference)
+}
+
+// FromLogIndex is the inverse of LogIndex: reference * 10^(index / scale)
+func FromLogIndex(index, reference, scale float64) float64 {
+	return reference * math.Pow(10, index/scale)
+}
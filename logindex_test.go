@@ -0,0 +1,60 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNegLog10(t *testing.T) {
+	tests := []struct {
+		value, p float64
+	}{
+		{1e-7, 7},
+		{1, 0},
+		{0.01, 2},
+		{100, -2},
+		{2.5e-4, 3.6020599913279625},
+	}
+
+	for _, tt := range tests {
+		if got := NegLog10(tt.value); math.Abs(got-tt.p) > 1e-12 {
+			t.Errorf("NegLog10(%v) = %v, want %v", tt.value, got, tt.p)
+		}
+		if got := FromNegLog10(tt.p); !closeRel(got, tt.value) {
+			t.Errorf("FromNegLog10(%v) = %v, want %v", tt.p, got, tt.value)
+		}
+	}
+
+	if got := NegLog10(0); !math.IsInf(got, 1) {
+		t.Errorf("NegLog10(0) = %v, want +Inf", got)
+	}
+}
+
+func TestLogIndex(t *testing.T) {
+	tests := []struct {
+		name                    string
+		value, reference, scale float64
+		index                   float64
+	}{
+		{"richter", 1000, 1, 1, 3},
+		{"decibels", 100, 1, 10, 20},
+		{"stellar magnitude", 1, 100, -2.5, 5},
+		{"reference", 42, 42, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LogIndex(tt.value, tt.reference, tt.scale)
+			if math.Abs(got-tt.index) > 1e-12 {
+				t.Errorf("LogIndex() = %v, want %v", got, tt.index)
+			}
+			if back := FromLogIndex(got, tt.reference, tt.scale); !closeRel(back, tt.value) {
+				t.Errorf("FromLogIndex() = %v, want %v", back, tt.value)
+			}
+		})
+	}
+
+	if got, want := LogIndex(2, 1, 10), ToDecibels(2); got != want {
+		t.Errorf("LogIndex(2, 1, 10) = %v, want ToDecibels(2) = %v", got, want)
+	}
+}
@@ -0,0 +1,45 @@
+package mathx
+
+import "golang.org/x/exp/constraints"
+
+// SumMap returns the sum of the values of m, e.g. the total of per-label counters
+func SumMap[K comparable, V constraints.Integer | constraints.Float](m map[K]V) V {
+	var sum V
+	for _, v := range m {
+		sum += v
+	}
+	return sum
+}
+
+// MaxMap returns the largest value of m, or the zero value if m is empty
+func MaxMap[K comparable, V constraints.Ordered](m map[K]V) V {
+	var best V
+	first := true
+	for _, v := range m {
+		if first || v > best {
+			best, first = v, false
+		}
+	}
+	return best
+}
+
+// MinMap returns the smallest value of m, or the zero value if m is empty
+func MinMap[K comparable, V constraints.Ordered](m map[K]V) V {
+	var best V
+	first := true
+	for _, v := range m {
+		if first || v < best {
+			best, first = v, false
+		}
+	}
+	return best
+}
+
+// AverageMap returns the mean of the values of m, or 0 if m is empty. Like Average it
+// divides in decimal.
+func AverageMap[K comparable, V constraints.Integer | constraints.Float](m map[K]V) float64 {
+	if len(m) == 0 {
+		return 0
+	}
+	return Div(float64(SumMap(m)), float64(len(m)), statsPrecision).Float64()
+}
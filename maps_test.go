@@ -0,0 +1,35 @@
+package mathx
+
+import "testing"
+
+func TestMapAggregations(t *testing.T) {
+	latency := map[string]float64{"eu": 120.5, "us": -3, "ap": 310}
+	if got := SumMap(latency); got != 427.5 {
+		t.Errorf("SumMap() = %v, want 427.5", got)
+	}
+	if got := MaxMap(latency); got != 310 {
+		t.Errorf("MaxMap() = %v, want 310", got)
+	}
+	if got := MinMap(latency); got != -3 {
+		t.Errorf("MinMap() = %v, want -3", got)
+	}
+	if got := AverageMap(latency); got != 142.5 {
+		t.Errorf("AverageMap() = %v, want 142.5", got)
+	}
+
+	negative := map[int]int64{1: -5, 2: -9, 3: -7}
+	if got := MaxMap(negative); got != -5 {
+		t.Errorf("MaxMap() of negatives = %v, want -5", got)
+	}
+	if got := AverageMap(negative); got != -7 {
+		t.Errorf("AverageMap() of ints = %v, want -7", got)
+	}
+	if got := MinMap(map[string]string{"a": "pear", "b": "apple"}); got != "apple" {
+		t.Errorf("MinMap() of strings = %v, want apple", got)
+	}
+
+	var empty map[string]int
+	if SumMap(empty) != 0 || MaxMap(empty) != 0 || MinMap(empty) != 0 || AverageMap(empty) != 0 {
+		t.Error("aggregations of an empty map must be zero")
+	}
+}
@@ -3,6 +3,7 @@ package mathx
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/shopspring/decimal"
@@ -57,6 +58,30 @@ func DivSafe(a, b decimal.Decimal, precision int32) Result {
 	return Result{v: result}
 }
 
+// DivE is like Div but returns ErrDivisionByZero instead of panicking when b is zero
+func DivE(a, b float64, precision int32) (Result, error) {
+	return DivSafeE(decimal.NewFromFloat(a), decimal.NewFromFloat(b), precision)
+}
+
+// DivSafeE is like DivSafe but returns ErrDivisionByZero instead of panicking when b is zero
+func DivSafeE(a, b decimal.Decimal, precision int32) (Result, error) {
+	if b.IsZero() {
+		return Result{}, ErrDivisionByZero
+	}
+	return DivSafe(a, b, precision), nil
+}
+
+// SafeDivE divides dividend by divisor rounded to precision decimal places and returns
+// the quotient as a float64, or ErrDivisionByZero if divisor is zero. Unlike a division
+// that falls back to 0, a zero quotient and a zero divisor stay distinguishable.
+func SafeDivE(dividend, divisor float64, precision int32) (float64, error) {
+	r, err := DivE(dividend, divisor, precision)
+	if err != nil {
+		return 0, err
+	}
+	return r.Float64(), nil
+}
+
 // DivTrunc truncates the division of two float64 values and returns a Result
 func DivTrunc(a, b float64, precision int32) Result {
 	result := decimal.NewFromFloat(a).Div(decimal.NewFromFloat(b)).Truncate(precision)
@@ -86,7 +111,7 @@ func Truncate(value float64, precision int32) Result {
 	if precision < 0 {
 		// For negative precision, truncate to integer places
 		// e.g., precision -1 means truncate to tens place
-		multiplier := decimal.NewFromFloat(math.Pow(10, float64(-precision)))
+		multiplier := pow10Decimal(-precision)
 		result := decimal.NewFromFloat(value).Div(multiplier).Truncate(0).Mul(multiplier)
 		return Result{v: result}
 	}
@@ -99,7 +124,7 @@ func TruncateSafe(a decimal.Decimal, precision int32) Result {
 	if precision < 0 {
 		// For negative precision, truncate to integer places
 		// e.g., precision -1 means truncate to tens place
-		multiplier := decimal.NewFromFloat(math.Pow(10, float64(-precision)))
+		multiplier := pow10Decimal(-precision)
 		result := a.Div(multiplier).Truncate(0).Mul(multiplier)
 		return Result{v: result}
 	}
@@ -128,6 +153,41 @@ func Int64MulFloat64(multiplicand int64, multiplier float64) float64 {
 	return f
 }
 
+// FloorDiv divides two int64 values and rounds the quotient toward negative infinity,
+// e.g. FloorDiv(-7, 2) == -4 whereas -7/2 == -3. It panics if b is zero.
+func FloorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// Mod returns the floored modulo of two int64 values, whose sign matches the divisor,
+// e.g. Mod(-7, 3) == 2 and Mod(7, -3) == -2. It panics if b is zero.
+// Mod and FloorDiv satisfy a == FloorDiv(a, b)*b + Mod(a, b).
+func Mod(a, b int64) int64 {
+	m := a % b
+	if m != 0 && ((m < 0) != (b < 0)) {
+		m += b
+	}
+	return m
+}
+
+// EuclidMod returns the Euclidean modulo of two int64 values, which is always non-negative,
+// e.g. EuclidMod(-7, 3) == 2 and EuclidMod(-7, -3) == 2. It panics if b is zero.
+func EuclidMod(a, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		if b < 0 {
+			m -= b
+		} else {
+			m += b
+		}
+	}
+	return m
+}
+
 // Max returns the maximum value from a slice of numbers
 func Max[T constraints.Ordered](ns ...T) T {
 	if len(ns) == 0 {
@@ -158,6 +218,24 @@ func Min[T constraints.Ordered](ns ...T) T {
 	return min
 }
 
+// MaxE is like Max but returns ErrEmptyInput for an empty slice instead of the zero value
+func MaxE[T constraints.Ordered](ns ...T) (T, error) {
+	if len(ns) == 0 {
+		var zero T
+		return zero, ErrEmptyInput
+	}
+	return Max(ns...), nil
+}
+
+// MinE is like Min but returns ErrEmptyInput for an empty slice instead of the zero value
+func MinE[T constraints.Ordered](ns ...T) (T, error) {
+	if len(ns) == 0 {
+		var zero T
+		return zero, ErrEmptyInput
+	}
+	return Min(ns...), nil
+}
+
 // Sum returns the sum of a slice of numbers
 func Sum[T constraints.Integer | constraints.Float](ns ...T) T {
 	var sum T
@@ -167,16 +245,23 @@ func Sum[T constraints.Integer | constraints.Float](ns ...T) T {
 	return sum
 }
 
+// SumChecked returns the sum of a slice of integers, or ErrOverflow if the sum or
+// any partial sum does not fit in T. Use SumBig when the exact total is needed anyway.
+func SumChecked[T constraints.Integer](ns ...T) (T, error) {
+	var sum T
+	for _, n := range ns {
+		next := sum + n
+		if (n > 0 && next < sum) || (n < 0 && next > sum) {
+			return 0, ErrOverflow
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
 // SumSafe returns the sum of decimal values
 func SumSafe(ds ...decimal.Decimal) decimal.Decimal {
-	if len(ds) == 0 {
-		return decimal.Zero
-	}
-	sum := ds[0]
-	for _, d := range ds[1:] {
-		sum = sum.Add(d)
-	}
-	return sum
+	return sumDecimals(ds)
 }
 
 // MaxSafe returns the maximum decimal value
@@ -209,6 +294,12 @@ func MinSafe(ds ...decimal.Decimal) decimal.Decimal {
 
 // Abs returns the absolute value of a number
 func Abs(value float64) float64 {
+	return AbsT(value)
+}
+
+// AbsT returns the absolute value of any integer or float number.
+// Note that for signed integers the absolute value of the minimum value overflows.
+func AbsT[T constraints.Integer | constraints.Float](value T) T {
 	if value < 0 {
 		return -value
 	}
@@ -266,11 +357,11 @@ func IsEqual(a, b float64, precision int32) bool {
 }
 
 func IsEqualSafe(a, b decimal.Decimal, precision int32) bool {
-	return a.Sub(b).Abs().LessThan(decimal.NewFromFloat(math.Pow(10, -float64(precision))))
+	return a.Sub(b).Abs().LessThan(pow10Decimal(-precision))
 }
 
 // Clamp clamps a value between min and max
-func Clamp(value, min, max float64) float64 {
+func Clamp[T constraints.Integer | constraints.Float](value, min, max T) T {
 	if value < min {
 		return min
 	}
@@ -291,18 +382,95 @@ func ClampSafe(a decimal.Decimal, min, max decimal.Decimal) decimal.Decimal {
 	return a
 }
 
+// Wrap wraps value into the half-open range [min, max) instead of clamping it,
+// for cyclic quantities such as angles, e.g. Wrap(370, 0, 360) == 10 and
+// Wrap(-90, -180, 180) == -90. It returns min if max <= min.
+func Wrap(value, min, max float64) float64 {
+	width := max - min
+	if !(width > 0) {
+		return min
+	}
+	r := math.Mod(value-min, width)
+	if r < 0 {
+		r += width
+	}
+	// a tiny negative remainder can round up to width
+	if r >= width {
+		r = 0
+	}
+	return min + r
+}
+
+// WrapInt wraps value into the half-open range [min, max), e.g. for cyclic indices
+// WrapInt(-1, 0, 5) == 4. It returns min if max <= min.
+func WrapInt(value, min, max int64) int64 {
+	if max <= min {
+		return min
+	}
+	return min + EuclidMod(value-min, max-min)
+}
+
 // Lerp performs linear interpolation between two values
 func Lerp(a, b, t float64) float64 {
 	return Add(a, Mul(Sub(b, a).Float64(), t).Float64()).Float64()
 }
 
+// InverseLerp returns the interpolation factor t such that Lerp(a, b, t) == v.
+// It returns 0 when a equals b.
+func InverseLerp(a, b, v float64) float64 {
+	if a == b {
+		return 0
+	}
+	return Div(Sub(v, a).Float64(), Sub(b, a).Float64(), 16).Float64()
+}
+
+// MapRange maps v from the range [inLo, inHi] to the range [outLo, outHi].
+// If clamp is true, the result is limited to the output range.
+func MapRange(v, inLo, inHi, outLo, outHi float64, clamp bool) float64 {
+	t := InverseLerp(inLo, inHi, v)
+	if clamp {
+		t = Clamp(t, 0, 1)
+	}
+	return Lerp(outLo, outHi, t)
+}
+
+// LogScale maps v from [domainMin, domainMax] to [rangeMin, rangeMax] on a logarithmic
+// scale, so equal ratios in the domain map to equal distances in the range, e.g.
+// LogScale(100, 1, 10000, 0, 4) == 2. Values outside the domain are extrapolated. The
+// domain and v must be positive; otherwise it returns NaN.
+func LogScale(v, domainMin, domainMax, rangeMin, rangeMax float64) float64 {
+	if !(v > 0 && domainMin > 0 && domainMax > 0) {
+		return math.NaN()
+	}
+	return MapRange(math.Log(v), math.Log(domainMin), math.Log(domainMax), rangeMin, rangeMax, false)
+}
+
+// PowScale maps v from [domainMin, domainMax] to [rangeMin, rangeMax] after raising the
+// domain and v to exponent, e.g. 0.5 for a square-root scale that sizes circles by area.
+// Negative values keep their sign: x is transformed as sign(x) * |x|^exponent.
+func PowScale(v, domainMin, domainMax, rangeMin, rangeMax, exponent float64) float64 {
+	pow := func(x float64) float64 {
+		return math.Copysign(math.Pow(math.Abs(x), exponent), x)
+	}
+	return MapRange(pow(v), pow(domainMin), pow(domainMax), rangeMin, rangeMax, false)
+}
+
 // Average calculates the average of a slice of numbers
 func Average[T constraints.Integer | constraints.Float](ns ...T) float64 {
 	if len(ns) == 0 {
 		return 0
 	}
-	sum := Sum(ns...)
-	return Div(float64(sum), float64(len(ns)), 32).Float64()
+	sum := decimal.NewFromFloat(float64(Sum(ns...)))
+	f, _ := sum.DivRound(decimal.NewFromInt(int64(len(ns))), 32).Float64()
+	return f
+}
+
+// AverageE is like Average but returns ErrEmptyInput for an empty slice instead of 0
+func AverageE[T constraints.Integer | constraints.Float](ns ...T) (float64, error) {
+	if len(ns) == 0 {
+		return 0, ErrEmptyInput
+	}
+	return Average(ns...), nil
 }
 
 // AverageSafe calculates the average of a slice of decimal values
@@ -345,7 +513,8 @@ func FormatCurrency(amount float64, decimalPlaces int32) string {
 	return fmt.Sprintf(format, rounded.Float64())
 }
 
-// ParseFloat safely parses a string to float64
+// ParseFloat safely parses a string to float64. Use ParseDecimal or ParseResult
+// to keep digits beyond float64 precision.
 func ParseFloat(s string) (float64, error) {
 	d, err := decimal.NewFromString(s)
 	if err != nil {
@@ -355,6 +524,58 @@ func ParseFloat(s string) (float64, error) {
 	return f, nil
 }
 
+// ParseDecimal parses a decimal string such as "-12.345" or "1.5e-3" without going
+// through float64, so no digits are lost. Surrounding whitespace is ignored.
+// It returns an error wrapping ErrInvalidFormat for malformed input.
+func ParseDecimal(s string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(strings.TrimSpace(s))
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return d, nil
+}
+
+// ParseResult is like ParseDecimal but returns a Result
+func ParseResult(s string) (Result, error) {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{v: d}, nil
+}
+
+// ParseMoney parses an amount formatted with thousands separators, such as the
+// output of FormatMoney ("-1,234,567.89"), into a Result without losing precision
+func ParseMoney(s string) (Result, error) {
+	str := strings.TrimSpace(s)
+	if strings.Contains(str, ",") {
+		digits := strings.TrimLeft(str, "+-")
+		integerPart, _, _ := strings.Cut(digits, ".")
+		groups := strings.Split(integerPart, ",")
+		for i, g := range groups {
+			if (i == 0 && (len(g) == 0 || len(g) > 3)) || (i > 0 && len(g) != 3) {
+				return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+			}
+		}
+		str = strings.ReplaceAll(str, ",", "")
+	}
+	d, err := decimal.NewFromString(str)
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return Result{v: d}, nil
+}
+
+// MustParseMoney is like ParseMoney but panics if the amount cannot be parsed.
+// It simplifies safe initialization of package-level amounts.
+func MustParseMoney(s string) Result {
+	r, err := ParseMoney(s)
+	if err != nil {
+		panic(`mathx: MustParseMoney(` + strconv.Quote(s) + `): ` + err.Error())
+	}
+	return r
+}
+
 // ToFixed formats a number to a fixed number of decimal places
 func ToFixed(value float64, places int32) float64 {
 	return Round(value, places).Float64()
@@ -370,8 +591,27 @@ func IsNegative(value float64) bool {
 	return value < 0
 }
 
+// IsZeroTol reports whether |value| <= tol. A negative tol is treated as its absolute value.
+func IsZeroTol(value, tol float64) bool {
+	return math.Abs(value) <= math.Abs(tol)
+}
+
+// IsNearlyInteger reports whether value lies within tol of an integer, e.g. to accept
+// 2.9999999999999996 as 3. NaN and ±Inf are never nearly integers.
+func IsNearlyInteger(value, tol float64) bool {
+	if math.IsInf(value, 0) {
+		return false
+	}
+	return IsZeroTol(value-math.Round(value), tol)
+}
+
 // Sign returns the sign of a number (-1, 0, or 1)
 func Sign(value float64) int {
+	return SignT(value)
+}
+
+// SignT returns the sign of any integer or float number (-1, 0, or 1)
+func SignT[T constraints.Integer | constraints.Float](value T) int {
 	if value > 0 {
 		return 1
 	}
@@ -383,12 +623,14 @@ func Sign(value float64) int {
 
 // ToString converts a float64 to string
 func ToString(value float64) string {
-	return decimal.NewFromFloat(value).String()
+	var buf [64]byte
+	return string(AppendString(buf[:0], value))
 }
 
 // ToStringFixed converts a float64 to string with fixed decimal places
 func ToStringFixed(value float64, places int32) string {
-	return decimal.NewFromFloat(value).StringFixed(places)
+	var buf [64]byte
+	return string(AppendFixed(buf[:0], value, places))
 }
 
 // ToStringBank converts a float64 to string with banker's rounding
@@ -398,96 +640,37 @@ func ToStringBank(value float64, places int32) string {
 
 // FormatMoney formats a number as currency with thousands separator
 func FormatMoney(amount float64, decimalPlaces int32) string {
-	d := decimal.NewFromFloat(amount)
-	rounded := d.Round(decimalPlaces)
-
-	// 转换为字符串并添加千位分隔符
-	str := rounded.String()
-
-	// 分离整数和小数部分
-	parts := strings.Split(str, ".")
-	integerPart := parts[0]
-	decimalPart := ""
-	if len(parts) > 1 {
-		decimalPart = "." + parts[1]
-	}
-
-	// 添加千位分隔符
-	if len(integerPart) > 3 {
-		var result strings.Builder
-		for i, char := range integerPart {
-			if i > 0 && (len(integerPart)-i)%3 == 0 {
-				result.WriteString(",")
-			}
-			result.WriteRune(char)
-		}
-		integerPart = result.String()
-	}
-
-	return integerPart + decimalPart
+	var buf [64]byte
+	return string(AppendMoney(buf[:0], amount, decimalPlaces))
 }
 
 // FormatMoneyInt formats an int64 as currency with thousands separator
 func FormatMoneyInt(amount int64, decimalPlaces int32) string {
-	d := decimal.NewFromInt(amount)
-	rounded := d.Round(decimalPlaces)
-
-	// 转换为字符串并添加千位分隔符
-	str := rounded.String()
-
-	// 分离整数和小数部分
-	parts := strings.Split(str, ".")
-	integerPart := parts[0]
-	decimalPart := ""
-	if len(parts) > 1 {
-		decimalPart = "." + parts[1]
-	} else {
-		decimalPart = ".00"
-	}
-
-	// 添加千位分隔符
-	if len(integerPart) > 3 {
-		var result strings.Builder
-		for i, char := range integerPart {
-			if i > 0 && (len(integerPart)-i)%3 == 0 {
-				result.WriteString(",")
-			}
-			result.WriteRune(char)
-		}
-		integerPart = result.String()
-	}
+	var buf [64]byte
+	return string(AppendMoneyInt(buf[:0], amount, decimalPlaces))
+}
+
+// FormatMoneyGrouped formats a number as currency with the integer digits grouped
+// as g says, e.g. "1'234'567.89" with GroupApostrophe or "1.234.567,89" with GroupPeriod
+func FormatMoneyGrouped(amount float64, decimalPlaces int32, g Grouping) string {
+	var buf [64]byte
+	return string(AppendMoneyGrouped(buf[:0], amount, decimalPlaces, g))
+}
 
-	return integerPart + decimalPart
+// FormatMoneyIntGrouped formats an int64 as currency with the integer digits grouped as g says
+func FormatMoneyIntGrouped(amount int64, decimalPlaces int32, g Grouping) string {
+	var buf [64]byte
+	return string(AppendMoneyIntGrouped(buf[:0], amount, decimalPlaces, g))
 }
 
 // RemoveTrailingZeros removes trailing zeros from a float64 string representation
 func RemoveTrailingZeros(value float64) string {
-	str := decimal.NewFromFloat(value).String()
-
-	// 如果包含小数点
-	if strings.Contains(str, ".") {
-		// 移除尾随的零
-		str = strings.TrimRight(str, "0")
-		// 如果小数点后没有数字了，也移除小数点
-		str = strings.TrimRight(str, ".")
-	}
-
-	return str
+	return normalizeDecimal(decimal.NewFromFloat(value)).String()
 }
 
 // RemoveTrailingZerosFixed removes trailing zeros from a float64 with fixed decimal places
 func RemoveTrailingZerosFixed(value float64, places int32) string {
-	str := decimal.NewFromFloat(value).StringFixed(places)
-
-	// 如果包含小数点
-	if strings.Contains(str, ".") {
-		// 移除尾随的零
-		str = strings.TrimRight(str, "0")
-		// 如果小数点后没有数字了，也移除小数点
-		str = strings.TrimRight(str, ".")
-	}
-
-	return str
+	return normalizeDecimal(decimal.NewFromFloat(value).Round(places)).String()
 }
 
 // CleanFloat removes trailing zeros and returns a clean float64
@@ -1,7 +1,10 @@
 package mathx
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -92,6 +95,147 @@ func TestResult_String(t *testing.T) {
 	}
 }
 
+func TestResult_Predicates(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     Result
+		isZero     bool
+		isPositive bool
+		isNegative bool
+		sign       int
+		isInteger  bool
+	}{
+		{"positive decimal", NewResult(3.14), false, true, false, 1, false},
+		{"negative integer", NewResult(-42), false, false, true, -1, true},
+		{"zero", Sub(0.3, 0.3), true, false, false, 0, true},
+		{"tiny", MustResultFromString("0.0000000000000000000001"), false, true, false, 1, false},
+		{"integer with scale", MustResultFromString("5.000"), false, true, false, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.result.IsZero(); got != tt.isZero {
+				t.Errorf("Result.IsZero() = %v, want %v", got, tt.isZero)
+			}
+			if got := tt.result.IsPositive(); got != tt.isPositive {
+				t.Errorf("Result.IsPositive() = %v, want %v", got, tt.isPositive)
+			}
+			if got := tt.result.IsNegative(); got != tt.isNegative {
+				t.Errorf("Result.IsNegative() = %v, want %v", got, tt.isNegative)
+			}
+			if got := tt.result.Sign(); got != tt.sign {
+				t.Errorf("Result.Sign() = %v, want %v", got, tt.sign)
+			}
+			if got := tt.result.IsInteger(); got != tt.isInteger {
+				t.Errorf("Result.IsInteger() = %v, want %v", got, tt.isInteger)
+			}
+		})
+	}
+}
+
+func TestResult_EqualKey(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"1.5", "1.50", true},
+		{"100", "1e2", true},
+		{"0", "0.000", true},
+		{"-0.10", "-0.1", true},
+		{"1.5", "1.51", false},
+		{"1", "-1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			a, b := MustResultFromString(tt.a), MustResultFromString(tt.b)
+			if got := a.Equal(b); got != tt.equal {
+				t.Errorf("Equal() = %v, want %v", got, tt.equal)
+			}
+			if got := a.Key() == b.Key(); got != tt.equal {
+				t.Errorf("Key() %q vs %q, equal = %v, want %v", a.Key(), b.Key(), got, tt.equal)
+			}
+			if got := a.Cmp(b) == 0; got != tt.equal {
+				t.Errorf("Cmp() = %v", a.Cmp(b))
+			}
+			if got := a.EqualValue(b); got != tt.equal {
+				t.Errorf("EqualValue() = %v, want %v", got, tt.equal)
+			}
+			if got := a.Normalize().Identical(b.Normalize()); got != tt.equal {
+				t.Errorf("Normalize().Identical() = %v, want %v", got, tt.equal)
+			}
+		})
+	}
+
+	set := map[string]Result{}
+	for _, r := range []Result{NewResult(0.3), Add(0.1, 0.2), MustResultFromString("0.300"), Result{}} {
+		set[r.Key()] = r
+	}
+	if len(set) != 2 {
+		t.Errorf("set has %d members, want 2", len(set))
+	}
+}
+
+func TestResult_Normalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		exponent int32
+	}{
+		{"1.500", "1.5", -1},
+		{"2.00", "2", 0},
+		{"-0.0100", "-0.01", -2},
+		{"1e3", "1000", 0},
+		{"1200", "1200", 0},
+		{"0.000", "0", 0},
+		{"12345678901234567890.1234567890000", "12345678901234567890.123456789", -9},
+		{"100000000000000000000.00", "100000000000000000000", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r := MustResultFromString(tt.input)
+			got := r.Normalize()
+			if got.String() != tt.expected || got.Exponent() != tt.exponent {
+				t.Errorf("Normalize() = %v (exp %d), want %v (exp %d)", got, got.Exponent(), tt.expected, tt.exponent)
+			}
+			if !got.EqualValue(r) {
+				t.Errorf("Normalize() changed the value of %v", r)
+			}
+		})
+	}
+
+	if a, b := MustResultFromString("1.10"), MustResultFromString("1.1"); a.Identical(b) || !a.Identical(a) {
+		t.Error("Identical() must compare representations")
+	}
+}
+
+func TestResult_ExponentNumDigits(t *testing.T) {
+	tests := []struct {
+		input     string
+		exponent  int32
+		numDigits int
+	}{
+		{"1.50", -2, 3},
+		{"12.345", -3, 5},
+		{"1200", 0, 4},
+		{"0.001", -3, 1},
+		{"-7", 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r := MustResultFromString(tt.input)
+			if got := r.Exponent(); got != tt.exponent {
+				t.Errorf("Result.Exponent() = %v, want %v", got, tt.exponent)
+			}
+			if got := r.NumDigits(); got != tt.numDigits {
+				t.Errorf("Result.NumDigits() = %v, want %v", got, tt.numDigits)
+			}
+		})
+	}
+}
+
 func TestResult_ToStringFixed(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -115,6 +259,41 @@ func TestResult_ToStringFixed(t *testing.T) {
 	}
 }
 
+func TestResult_Format(t *testing.T) {
+	r := MustResultFromString("1234.5678")
+	neg := MustResultFromString("-2.345")
+	tests := []struct {
+		name     string
+		format   string
+		value    Result
+		expected string
+	}{
+		{"v", "%v", r, "1234.5678"},
+		{"s", "%s", r, "1234.5678"},
+		{"f exact", "%f", r, "1234.5678"},
+		{"f precision", "%.2f", r, "1234.57"},
+		{"round half away from zero", "%.2f", neg, "-2.35"},
+		{"width", "%10.2f", r, "   1234.57"},
+		{"left", "%-10.1f|", r, "1234.6    |"},
+		{"zero pad", "%08.2f", neg, "-0002.35"},
+		{"plus", "%+.1f", r, "+1234.6"},
+		{"plus zero pad", "%+09.1f", r, "+001234.6"},
+		{"space", "% .0f", r, " 1235"},
+		{"quoted", "%q", neg, `"-2.345"`},
+		{"v precision", "%.1v", r, "1234.6"},
+		{"bad verb", "%d", r, "%!d(mathx.Result=1234.5678)"},
+		{"zero value", "%v", Result{}, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.value); got != tt.expected {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestResult_Clean(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -138,6 +317,119 @@ func TestResult_Clean(t *testing.T) {
 	}
 }
 
+func TestRemoveTrailingZeros_rounding(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		places   int32
+		expected string
+		fixed    string
+	}{
+		{"fraction", 3.14, 4, "3.14", "3.14"},
+		{"integer", 1200, 2, "1200", "1200"},
+		{"rounded away", 2.5, 0, "2.5", "3"},
+		{"negative", -0.125, 2, "-0.125", "-0.13"},
+		{"small negative", -0.001, 2, "-0.001", "0"},
+		{"negative places", 1234.5, -2, "1234.5", "1200"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RemoveTrailingZeros(tt.value); got != tt.expected {
+				t.Errorf("RemoveTrailingZeros() = %v, want %v", got, tt.expected)
+			}
+			if got := RemoveTrailingZerosFixed(tt.value, tt.places); got != tt.fixed {
+				t.Errorf("RemoveTrailingZerosFixed() = %v, want %v", got, tt.fixed)
+			}
+		})
+	}
+}
+
+func TestResult_IntFracPart(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		intPart  int64
+		fracPart string
+	}{
+		{"positive", "12.34", 12, "0.34"},
+		{"negative", "-1.25", -1, "-0.25"},
+		{"integer", "7", 7, "0"},
+		{"below one", "0.5", 0, "0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MustResultFromString(tt.value)
+			if got := result.IntPart(); got != tt.intPart {
+				t.Errorf("Result.IntPart() = %v, want %v", got, tt.intPart)
+			}
+			if got := result.FracPart().String(); got != tt.fracPart {
+				t.Errorf("Result.FracPart() = %v, want %v", got, tt.fracPart)
+			}
+		})
+	}
+}
+
+func TestResult_Int64Checked(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected int64
+		err      error
+	}{
+		{"integer", "42", 42, nil},
+		{"integer with scale", "-42.000", -42, nil},
+		{"max int64", "9223372036854775807", math.MaxInt64, nil},
+		{"min int64", "-9223372036854775808", math.MinInt64, nil},
+		{"fraction", "1.5", 0, ErrNotInteger},
+		{"overflow", "9223372036854775808", 0, ErrOverflow},
+		{"underflow", "-9223372036854775809", 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MustResultFromString(tt.value).Int64Checked()
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Result.Int64Checked() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.expected {
+				t.Errorf("Result.Int64Checked() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResult_IntChecked(t *testing.T) {
+	if got, err := Mul(2.5, 4).IntChecked(); err != nil || got != 10 {
+		t.Errorf("Result.IntChecked() = %v, %v, want 10", got, err)
+	}
+	if _, err := Div(1, 3, 2).IntChecked(); !errors.Is(err, ErrNotInteger) {
+		t.Errorf("Result.IntChecked() error = %v, want ErrNotInteger", err)
+	}
+}
+
+func TestResult_Shift(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		shift    int32
+		expected string
+	}{
+		{"dollars to cents", "12.34", 2, "1234"},
+		{"cents to dollars", "1234", -2, "12.34"},
+		{"no shift", "1.5", 0, "1.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MustResultFromString(tt.value).Shift(tt.shift).String(); got != tt.expected {
+				t.Errorf("Result.Shift() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestResult_Round(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -186,6 +478,58 @@ func TestResult_Truncate(t *testing.T) {
 	}
 }
 
+func TestResult_FloorCeil(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+		floor string
+		ceil  string
+	}{
+		{"positive", 3.14, "3", "4"},
+		{"negative", -3.14, "-4", "-3"},
+		{"integer", 5, "5", "5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewResult(tt.value)
+			if got := result.Floor().String(); got != tt.floor {
+				t.Errorf("Result.Floor() = %v, want %v", got, tt.floor)
+			}
+			if got := result.Ceil().String(); got != tt.ceil {
+				t.Errorf("Result.Ceil() = %v, want %v", got, tt.ceil)
+			}
+		})
+	}
+}
+
+func TestResult_RoundUpDown(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     float64
+		places    int32
+		roundUp   string
+		roundDown string
+	}{
+		{"positive", 1.1001, 2, "1.11", "1.1"},
+		{"negative", -1.454, 1, "-1.5", "-1.4"},
+		{"exact", 2.5, 1, "2.5", "2.5"},
+		{"negative places", 545, -2, "600", "500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewResult(tt.value)
+			if got := result.RoundUp(tt.places).String(); got != tt.roundUp {
+				t.Errorf("Result.RoundUp() = %v, want %v", got, tt.roundUp)
+			}
+			if got := result.RoundDown(tt.places).String(); got != tt.roundDown {
+				t.Errorf("Result.RoundDown() = %v, want %v", got, tt.roundDown)
+			}
+		})
+	}
+}
+
 func TestResult_FormatMoney(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -253,6 +597,36 @@ func TestResult_Neg(t *testing.T) {
 	}
 }
 
+func TestResult_MinMaxClamp(t *testing.T) {
+	fee := Mul(1000, 0.035) // 35
+	if got := fee.Min(decimal.NewFromInt(25)).String(); got != "25" {
+		t.Errorf("Result.Min() = %v, want 25", got)
+	}
+	if got := fee.Max(decimal.NewFromInt(50)).String(); got != "50" {
+		t.Errorf("Result.Max() = %v, want 50", got)
+	}
+	if got := fee.Max(decimal.NewFromInt(10)).String(); got != "35" {
+		t.Errorf("Result.Max() = %v, want 35", got)
+	}
+
+	tests := []struct {
+		name     string
+		value    float64
+		expected string
+	}{
+		{"within range", 5, "5"},
+		{"below", -1, "0"},
+		{"above", 12.5, "10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewResult(tt.value).Clamp(decimal.Zero, decimal.NewFromInt(10)).String(); got != tt.expected {
+				t.Errorf("Result.Clamp() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestResult_Add(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -350,6 +724,42 @@ func TestResult_Div(t *testing.T) {
 	}
 }
 
+func TestResult_Apply(t *testing.T) {
+	capAt := func(limit decimal.Decimal) func(decimal.Decimal) decimal.Decimal {
+		return func(d decimal.Decimal) decimal.Decimal {
+			return decimal.Min(d, limit)
+		}
+	}
+
+	got := Mul(120, 0.25).Apply(capAt(decimal.NewFromInt(25))).Round(2).ToStringFixed(2)
+	if got != "25.00" {
+		t.Errorf("Result.Apply() = %v, want 25.00", got)
+	}
+	got = Mul(80, 0.25).Apply(capAt(decimal.NewFromInt(25))).ToString()
+	if got != "20" {
+		t.Errorf("Result.Apply() = %v, want 20", got)
+	}
+}
+
+func TestResult_ApplyE(t *testing.T) {
+	errNegative := errors.New("negative amount")
+	nonNegative := func(d decimal.Decimal) (decimal.Decimal, error) {
+		if d.IsNegative() {
+			return d, errNegative
+		}
+		return d.Mul(decimal.RequireFromString("1.1")), nil
+	}
+
+	got, err := NewResult(10).ApplyE(nonNegative)
+	if err != nil || got.String() != "11" {
+		t.Errorf("Result.ApplyE() = %v, %v, want 11", got, err)
+	}
+	got, err = NewResult(-10).ApplyE(nonNegative)
+	if !errors.Is(err, errNegative) || got.String() != "-10" {
+		t.Errorf("Result.ApplyE() = %v, %v, want -10 and error", got, err)
+	}
+}
+
 func TestAdd(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -443,6 +853,40 @@ func TestDiv(t *testing.T) {
 	}
 }
 
+func TestDivE(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         float64
+		b         float64
+		precision int32
+		expected  float64
+		wantErr   error
+	}{
+		{"simple division", 10.0, 2.0, 2, 5.0, nil},
+		{"decimal division", 1.0, 3.0, 2, 0.33, nil},
+		{"zero result", 0, 5, 2, 0, nil},
+		{"zero divisor", 5, 0, 2, 0, ErrDivisionByZero},
+		{"zero by zero", 0, 0, 2, 0, ErrDivisionByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SafeDivE(tt.a, tt.b, tt.precision)
+			if !errors.Is(err, tt.wantErr) || got != tt.expected {
+				t.Errorf("SafeDivE() = %v, %v, want %v, %v", got, err, tt.expected, tt.wantErr)
+			}
+			r, err := DivE(tt.a, tt.b, tt.precision)
+			if !errors.Is(err, tt.wantErr) || r.Float64() != tt.expected {
+				t.Errorf("DivE() = %v, %v, want %v, %v", r, err, tt.expected, tt.wantErr)
+			}
+			r, err = DivSafeE(decimal.NewFromFloat(tt.a), decimal.NewFromFloat(tt.b), tt.precision)
+			if !errors.Is(err, tt.wantErr) || r.Float64() != tt.expected {
+				t.Errorf("DivSafeE() = %v, %v, want %v, %v", r, err, tt.expected, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestDivTrunc(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -532,6 +976,18 @@ func TestAbs(t *testing.T) {
 	}
 }
 
+func TestAbsT(t *testing.T) {
+	if got := AbsT(-5); got != 5 {
+		t.Errorf("AbsT(-5) = %v, want 5", got)
+	}
+	if got := AbsT(int64(7)); got != 7 {
+		t.Errorf("AbsT(7) = %v, want 7", got)
+	}
+	if got := AbsT(float32(-1.5)); got != 1.5 {
+		t.Errorf("AbsT(-1.5) = %v, want 1.5", got)
+	}
+}
+
 func TestCeil(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -663,6 +1119,69 @@ func TestClamp(t *testing.T) {
 	}
 }
 
+func TestClamp_generic(t *testing.T) {
+	if got := Clamp(15, 0, 10); got != 10 {
+		t.Errorf("Clamp(15, 0, 10) = %v, want 10", got)
+	}
+	if got := Clamp(int64(-3), 0, 10); got != 0 {
+		t.Errorf("Clamp(-3, 0, 10) = %v, want 0", got)
+	}
+	if got := Clamp(float32(2.5), 0, 10); got != 2.5 {
+		t.Errorf("Clamp(2.5, 0, 10) = %v, want 2.5", got)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		min      float64
+		max      float64
+		expected float64
+	}{
+		{"in range", 45, 0, 360, 45},
+		{"above", 370, 0, 360, 10},
+		{"max wraps to min", 360, 0, 360, 0},
+		{"negative", -30, 0, 360, 330},
+		{"symmetric range", 190, -180, 180, -170},
+		{"hours", 25.5, 0, 24, 1.5},
+		{"many turns", -725, 0, 360, 355},
+		{"tiny negative", -1e-20, 0, 360, 0},
+		{"empty range", 5, 3, 3, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Wrap(tt.value, tt.min, tt.max)
+			if got != tt.expected {
+				t.Errorf("Wrap(%v, %v, %v) = %v, want %v", tt.value, tt.min, tt.max, got, tt.expected)
+			}
+			if got < tt.min || (tt.max > tt.min && got >= tt.max) {
+				t.Errorf("Wrap(%v, %v, %v) = %v is out of range", tt.value, tt.min, tt.max, got)
+			}
+		})
+	}
+}
+
+func TestWrapInt(t *testing.T) {
+	tests := []struct {
+		value, min, max, expected int64
+	}{
+		{-1, 0, 5, 4},
+		{5, 0, 5, 0},
+		{12, 0, 5, 2},
+		{3, 1, 4, 3},
+		{0, 1, 4, 3},
+		{7, 2, 2, 2},
+	}
+
+	for _, tt := range tests {
+		if got := WrapInt(tt.value, tt.min, tt.max); got != tt.expected {
+			t.Errorf("WrapInt(%v, %v, %v) = %v, want %v", tt.value, tt.min, tt.max, got, tt.expected)
+		}
+	}
+}
+
 func TestLerp(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -686,6 +1205,117 @@ func TestLerp(t *testing.T) {
 	}
 }
 
+func TestInverseLerp(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        float64
+		b        float64
+		v        float64
+		expected float64
+	}{
+		{"start", 0.0, 10.0, 0.0, 0.0},
+		{"end", 0.0, 10.0, 10.0, 1.0},
+		{"middle", 0.0, 10.0, 5.0, 0.5},
+		{"outside", 0.0, 10.0, 15.0, 1.5},
+		{"reversed range", 10.0, 0.0, 2.5, 0.75},
+		{"empty range", 5.0, 5.0, 5.0, 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InverseLerp(tt.a, tt.b, tt.v); math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("InverseLerp() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        float64
+		inLo     float64
+		inHi     float64
+		outLo    float64
+		outHi    float64
+		clamp    bool
+		expected float64
+	}{
+		{"sensor to percent", 512, 0, 1024, 0, 100, false, 50},
+		{"celsius to fahrenheit", 100, 0, 100, 32, 212, false, 212},
+		{"above range unclamped", 150, 0, 100, 0, 1, false, 1.5},
+		{"above range clamped", 150, 0, 100, 0, 1, true, 1},
+		{"below range clamped", -10, 0, 100, 0, 1, true, 0},
+		{"inverted output", 25, 0, 100, 1, 0, false, 0.75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MapRange(tt.v, tt.inLo, tt.inHi, tt.outLo, tt.outHi, tt.clamp)
+			if math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("MapRange() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLogScale(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        float64
+		expected float64
+	}{
+		{"domain start", 1, 0},
+		{"decade", 100, 2},
+		{"domain end", 10000, 4},
+		{"extrapolated", 100000, 5},
+		{"between decades", math.Sqrt(10), 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LogScale(tt.v, 1, 10000, 0, 4)
+			if math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("LogScale() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if got := LogScale(20, 10, 1000, 500, 0); math.Abs(got-(500-500*math.Log(2)/math.Log(100))) > 1e-9 {
+		t.Errorf("LogScale() with inverted range = %v", got)
+	}
+	for _, v := range []float64{0, -5} {
+		if got := LogScale(v, 1, 100, 0, 1); !math.IsNaN(got) {
+			t.Errorf("LogScale(%v) = %v, want NaN", v, got)
+		}
+	}
+}
+
+func TestPowScale(t *testing.T) {
+	tests := []struct {
+		name                 string
+		v                    float64
+		domainMin, domainMax float64
+		exponent             float64
+		expected             float64
+	}{
+		{"square root", 25, 0, 100, 0.5, 50},
+		{"square", 5, 0, 10, 2, 25},
+		{"linear", 30, 0, 100, 1, 30},
+		{"signed square root", -25, -100, 100, 0.5, 25},
+		{"signed square", -5, -10, 10, 2, 37.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PowScale(tt.v, tt.domainMin, tt.domainMax, 0, 100, tt.exponent)
+			if math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("PowScale() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestAverage(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -727,6 +1357,138 @@ func TestStandardDeviation(t *testing.T) {
 	}
 }
 
+// The previous implementations built on Add, Sub, Mul and Div, kept to check that the
+// rewritten internals return bit-identical results
+func lerpPrevious(a, b, t float64) float64 {
+	return Add(a, Mul(Sub(b, a).Float64(), t).Float64()).Float64()
+}
+
+func averagePrevious(ns ...float64) float64 {
+	if len(ns) == 0 {
+		return 0
+	}
+	return Div(Sum(ns...), float64(len(ns)), 32).Float64()
+}
+
+func standardDeviationPrevious(ns ...float64) float64 {
+	if len(ns) < 2 {
+		return 0
+	}
+	avg := averagePrevious(ns...)
+	var sum float64
+	for _, n := range ns {
+		diff := Sub(n, avg).Float64()
+		sum = Add(sum, Mul(diff, diff).Float64()).Float64()
+	}
+	return Sqrt(Div(sum, float64(len(ns)-1), 10).Float64())
+}
+
+func TestLerpAverageStd_matchPrevious(t *testing.T) {
+	rng := rand.New(rand.NewSource(353))
+	value := func() float64 {
+		switch rng.Intn(3) {
+		case 0:
+			return float64(rng.Intn(2001)-1000) / 100
+		case 1:
+			return rng.NormFloat64() * 1e6
+		}
+		return rng.Float64()
+	}
+	same := func(a, b float64) bool { return math.Float64bits(a) == math.Float64bits(b) }
+
+	for i := 0; i < 2000; i++ {
+		a, b, u := value(), value(), rng.Float64()*1.5-0.25
+		if got, want := Lerp(a, b, u), lerpPrevious(a, b, u); !same(got, want) {
+			t.Fatalf("Lerp(%v, %v, %v) = %v, previously %v", a, b, u, got, want)
+		}
+
+		ns := make([]float64, 1+rng.Intn(8))
+		for j := range ns {
+			ns[j] = value()
+		}
+		if got, want := Average(ns...), averagePrevious(ns...); !same(got, want) {
+			t.Fatalf("Average(%v) = %v, previously %v", ns, got, want)
+		}
+		if got, want := StandardDeviation(ns...), standardDeviationPrevious(ns...); !same(got, want) {
+			t.Fatalf("StandardDeviation(%v) = %v, previously %v", ns, got, want)
+		}
+	}
+}
+
+func TestFloorDiv(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        int64
+		b        int64
+		expected int64
+	}{
+		{"positive operands", 7, 2, 3},
+		{"negative dividend", -7, 2, -4},
+		{"negative divisor", 7, -2, -4},
+		{"both negative", -7, -2, 3},
+		{"exact negative", -8, 2, -4},
+		{"zero dividend", 0, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FloorDiv(tt.a, tt.b); got != tt.expected {
+				t.Errorf("FloorDiv() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        int64
+		b        int64
+		expected int64
+	}{
+		{"positive operands", 7, 3, 1},
+		{"negative dividend", -7, 3, 2},
+		{"negative divisor", 7, -3, -2},
+		{"both negative", -7, -3, -1},
+		{"exact", -9, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Mod(tt.a, tt.b)
+			if got != tt.expected {
+				t.Errorf("Mod() = %v, want %v", got, tt.expected)
+			}
+			if FloorDiv(tt.a, tt.b)*tt.b+got != tt.a {
+				t.Errorf("FloorDiv*b + Mod != a for %d, %d", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestEuclidMod(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        int64
+		b        int64
+		expected int64
+	}{
+		{"positive operands", 7, 3, 1},
+		{"negative dividend", -7, 3, 2},
+		{"negative divisor", 7, -3, 1},
+		{"both negative", -7, -3, 2},
+		{"hour of day", -1, 24, 23},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EuclidMod(tt.a, tt.b); got != tt.expected {
+				t.Errorf("EuclidMod() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestMax(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -771,6 +1533,29 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func TestMaxMinAverageE(t *testing.T) {
+	values := []float64{-4, -1.5, -9}
+	if got, err := MaxE(values...); got != -1.5 || err != nil {
+		t.Errorf("MaxE() = %v, %v, want -1.5, nil", got, err)
+	}
+	if got, err := MinE(values...); got != -9 || err != nil {
+		t.Errorf("MinE() = %v, %v, want -9, nil", got, err)
+	}
+	if got, err := AverageE(values...); math.Abs(got+14.5/3) > 1e-12 || err != nil {
+		t.Errorf("AverageE() = %v, %v, want %v, nil", got, err, -14.5/3)
+	}
+
+	if _, err := MaxE[float64](); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("MaxE() error = %v, want ErrEmptyInput", err)
+	}
+	if _, err := MinE[string](); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("MinE() error = %v, want ErrEmptyInput", err)
+	}
+	if _, err := AverageE[int](); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("AverageE() error = %v, want ErrEmptyInput", err)
+	}
+}
+
 func TestSum(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -793,6 +1578,38 @@ func TestSum(t *testing.T) {
 	}
 }
 
+func TestSumChecked(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int64
+		expected int64
+		wantErr  error
+	}{
+		{"small", []int64{1, 2, 3}, 6, nil},
+		{"empty", nil, 0, nil},
+		{"max", []int64{math.MaxInt64 - 1, 1}, math.MaxInt64, nil},
+		{"overflow", []int64{math.MaxInt64, 1}, 0, ErrOverflow},
+		{"underflow", []int64{math.MinInt64, -1}, 0, ErrOverflow},
+		{"partial overflow", []int64{math.MaxInt64, 1, -1}, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SumChecked(tt.values...)
+			if got != tt.expected || !errors.Is(err, tt.wantErr) {
+				t.Errorf("SumChecked() = %v, %v, want %v, %v", got, err, tt.expected, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := SumChecked[uint8](200, 56); !errors.Is(err, ErrOverflow) {
+		t.Errorf("SumChecked[uint8]() error = %v, want ErrOverflow", err)
+	}
+	if got, err := SumChecked[uint8](200, 55); got != 255 || err != nil {
+		t.Errorf("SumChecked[uint8]() = %v, %v, want 255, nil", got, err)
+	}
+}
+
 func TestDivSafe(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -863,6 +1680,104 @@ func TestParseFloat(t *testing.T) {
 	}
 }
 
+func TestParseDecimalResult(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1234567890123456.789", "1234567890123456.789"},
+		{" -0.000000000000000000001 ", "-0.000000000000000000001"},
+		{"1.5e-3", "0.0015"},
+		{"+42", "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := ParseDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDecimal() error = %v", err)
+			}
+			if d.String() != tt.expected {
+				t.Errorf("ParseDecimal(%q) = %v, want %v", tt.input, d, tt.expected)
+			}
+			r, err := ParseResult(tt.input)
+			if err != nil || r.String() != tt.expected {
+				t.Errorf("ParseResult(%q) = %v, %v, want %v", tt.input, r, err, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "abc", "1,000", "1.2.3"} {
+		if _, err := ParseDecimal(input); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseDecimal(%q) error = %v, want ErrInvalidFormat", input, err)
+		}
+		if _, err := ParseResult(input); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseResult(%q) error = %v, want ErrInvalidFormat", input, err)
+		}
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		shouldErr bool
+	}{
+		{"grouped", "1,234,567.89", "1234567.89", false},
+		{"negative grouped", "-1,234.50", "-1234.5", false},
+		{"ungrouped", "1234.56", "1234.56", false},
+		{"small", "999", "999", false},
+		{"surrounding spaces", " 1,000 ", "1000", false},
+		{"high precision", "12,345,678,901,234,567.891", "12345678901234567.891", false},
+		{"bad group size", "1,23,456", "", true},
+		{"leading separator", ",123", "", true},
+		{"invalid", "abc", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.input)
+			if (err != nil) != tt.shouldErr {
+				t.Fatalf("ParseMoney() error = %v, wantErr %v", err, tt.shouldErr)
+			}
+			if !tt.shouldErr && got.String() != tt.expected {
+				t.Errorf("ParseMoney() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	for _, s := range []string{"1,2", "12a.5"} {
+		if _, err := ParseMoney(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseMoney(%q) error = %v, want ErrInvalidFormat", s, err)
+		}
+	}
+}
+
+func TestMustParseMoney(t *testing.T) {
+	if got := MustParseMoney("1,234.56").String(); got != "1234.56" {
+		t.Errorf("MustParseMoney() = %v, want 1234.56", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseMoney() did not panic on invalid input")
+		}
+	}()
+	MustParseMoney("12,34")
+}
+
+func TestMustResultFromString(t *testing.T) {
+	if got := MustResultFromString("3.14159265358979323846").String(); got != "3.14159265358979323846" {
+		t.Errorf("MustResultFromString() = %v", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustResultFromString() did not panic on invalid input")
+		}
+	}()
+	MustResultFromString("abc")
+}
+
 func TestToFixed(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -925,6 +1840,38 @@ func TestIsNegative(t *testing.T) {
 	}
 }
 
+func TestIsZeroTolNearlyInteger(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         float64
+		tol           float64
+		zero, integer bool
+	}{
+		{"exact zero", 0, 0, true, true},
+		{"tiny", 1e-12, 1e-10, true, true},
+		{"tiny negative", -1e-12, 1e-10, true, true},
+		{"outside tolerance", 1e-9, 1e-10, false, false},
+		{"negative tolerance", 1e-12, -1e-10, true, true},
+		{"whole", 3, 0, false, true},
+		{"below integer", 2.9999999999999996, 1e-12, false, true},
+		{"half", 2.5, 0.1, false, false},
+		{"loose tolerance", 2.95, 0.1, false, true},
+		{"NaN", math.NaN(), 1, false, false},
+		{"infinity", math.Inf(1), 1, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsZeroTol(tt.value, tt.tol); got != tt.zero {
+				t.Errorf("IsZeroTol(%v, %v) = %v, want %v", tt.value, tt.tol, got, tt.zero)
+			}
+			if got := IsNearlyInteger(tt.value, tt.tol); got != tt.integer {
+				t.Errorf("IsNearlyInteger(%v, %v) = %v, want %v", tt.value, tt.tol, got, tt.integer)
+			}
+		})
+	}
+}
+
 func TestSign(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -945,6 +1892,18 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignT(t *testing.T) {
+	if got := SignT(-42); got != -1 {
+		t.Errorf("SignT(-42) = %v, want -1", got)
+	}
+	if got := SignT(uint8(3)); got != 1 {
+		t.Errorf("SignT(3) = %v, want 1", got)
+	}
+	if got := SignT(float32(0)); got != 0 {
+		t.Errorf("SignT(0) = %v, want 0", got)
+	}
+}
+
 // Test chainable operations
 func TestChainableOperations(t *testing.T) {
 	// Test complex chainable operations
@@ -0,0 +1,253 @@
+package mathx
+
+import "math"
+
+// Matrix is a small dense row-major matrix of float64 values. It provides enough
+// linear algebra for curve fitting and portfolio math; it is not meant to compete
+// with dedicated numeric libraries on large inputs.
+type Matrix struct {
+	rows, cols int
+	data       []float64
+}
+
+// singularTolerance is the pivot size, relative to the largest entry of its original
+// row, below which Solve and Inverse treat a matrix as singular
+const singularTolerance = 1e-12
+
+// NewMatrix creates a rows×cols zero matrix
+func NewMatrix(rows, cols int) *Matrix {
+	return &Matrix{rows: rows, cols: cols, data: make([]float64, rows*cols)}
+}
+
+// NewMatrixFromRows creates a matrix from a slice of rows, which must all have the same length
+func NewMatrixFromRows(rows [][]float64) (*Matrix, error) {
+	if len(rows) == 0 {
+		return NewMatrix(0, 0), nil
+	}
+	m := NewMatrix(len(rows), len(rows[0]))
+	for i, row := range rows {
+		if len(row) != m.cols {
+			return nil, ErrDimensionMismatch
+		}
+		copy(m.data[i*m.cols:], row)
+	}
+	return m, nil
+}
+
+// IdentityMatrix creates an n×n identity matrix
+func IdentityMatrix(n int) *Matrix {
+	m := NewMatrix(n, n)
+	for i := 0; i < n; i++ {
+		m.data[i*n+i] = 1
+	}
+	return m
+}
+
+// Rows returns the number of rows
+func (m *Matrix) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of columns
+func (m *Matrix) Cols() int {
+	return m.cols
+}
+
+// At returns the element at row i and column j
+func (m *Matrix) At(i, j int) float64 {
+	return m.data[i*m.cols+j]
+}
+
+// Set sets the element at row i and column j
+func (m *Matrix) Set(i, j int, v float64) {
+	m.data[i*m.cols+j] = v
+}
+
+// ToRows returns a copy of the matrix as a slice of rows
+func (m *Matrix) ToRows() [][]float64 {
+	rows := make([][]float64, m.rows)
+	for i := range rows {
+		rows[i] = append([]float64(nil), m.data[i*m.cols:(i+1)*m.cols]...)
+	}
+	return rows
+}
+
+// Add returns the element-wise sum of m and other
+func (m *Matrix) Add(other *Matrix) (*Matrix, error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return nil, ErrDimensionMismatch
+	}
+	sum := NewMatrix(m.rows, m.cols)
+	for i := range m.data {
+		sum.data[i] = m.data[i] + other.data[i]
+	}
+	return sum, nil
+}
+
+// Mul returns the matrix product m × other
+func (m *Matrix) Mul(other *Matrix) (*Matrix, error) {
+	if m.cols != other.rows {
+		return nil, ErrDimensionMismatch
+	}
+	product := NewMatrix(m.rows, other.cols)
+	for i := 0; i < m.rows; i++ {
+		for k := 0; k < m.cols; k++ {
+			a := m.data[i*m.cols+k]
+			if a == 0 {
+				continue
+			}
+			for j := 0; j < other.cols; j++ {
+				product.data[i*other.cols+j] += a * other.data[k*other.cols+j]
+			}
+		}
+	}
+	return product, nil
+}
+
+// Transpose returns the transpose of m
+func (m *Matrix) Transpose() *Matrix {
+	t := NewMatrix(m.cols, m.rows)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			t.data[j*m.rows+i] = m.data[i*m.cols+j]
+		}
+	}
+	return t
+}
+
+// Determinant returns the determinant of a square matrix as the product of its LU
+// pivots. Only an exactly zero pivot makes it 0, so tiny determinants are kept.
+func (m *Matrix) Determinant() (float64, error) {
+	if m.rows != m.cols {
+		return 0, ErrDimensionMismatch
+	}
+	lu := m.decompose()
+	det := float64(lu.sign)
+	for i := 0; i < m.rows; i++ {
+		det *= lu.data[i*m.cols+i]
+	}
+	return det, nil
+}
+
+// Inverse returns the inverse of a square matrix, or ErrSingularMatrix
+func (m *Matrix) Inverse() (*Matrix, error) {
+	if m.rows != m.cols {
+		return nil, ErrDimensionMismatch
+	}
+	lu := m.decompose()
+	if lu.singular() {
+		return nil, ErrSingularMatrix
+	}
+	n := m.rows
+	inv := NewMatrix(n, n)
+	col := make([]float64, n)
+	for j := 0; j < n; j++ {
+		clear(col)
+		col[j] = 1
+		x := lu.solve(col)
+		for i := 0; i < n; i++ {
+			inv.data[i*n+j] = x[i]
+		}
+	}
+	return inv, nil
+}
+
+// Solve solves the linear system m × x = b for x using LU decomposition with partial pivoting
+func (m *Matrix) Solve(b []float64) ([]float64, error) {
+	if m.rows != m.cols || len(b) != m.rows {
+		return nil, ErrDimensionMismatch
+	}
+	lu := m.decompose()
+	if lu.singular() {
+		return nil, ErrSingularMatrix
+	}
+	return lu.solve(b), nil
+}
+
+// luDecomposition holds a combined L and U factorization with a row permutation
+type luDecomposition struct {
+	n        int
+	data     []float64 // L below the diagonal (unit diagonal implied), U on and above it
+	perm     []int
+	sign     int
+	rowScale []float64 // largest absolute entry of each original row
+}
+
+// decompose computes the LU decomposition of a square matrix with partial pivoting.
+// A column without a non-zero pivot is left as is, giving a zero on the diagonal of U.
+func (m *Matrix) decompose() *luDecomposition {
+	n := m.rows
+	lu := &luDecomposition{
+		n:        n,
+		data:     append([]float64(nil), m.data...),
+		perm:     make([]int, n),
+		sign:     1,
+		rowScale: make([]float64, n),
+	}
+	for i := range lu.perm {
+		lu.perm[i] = i
+		for j := 0; j < n; j++ {
+			lu.rowScale[i] = max(lu.rowScale[i], math.Abs(m.data[i*n+j]))
+		}
+	}
+
+	a := lu.data
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a[i*n+k]) > math.Abs(a[p*n+k]) {
+				p = i
+			}
+		}
+		if a[p*n+k] == 0 {
+			continue
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				a[k*n+j], a[p*n+j] = a[p*n+j], a[k*n+j]
+			}
+			lu.perm[k], lu.perm[p] = lu.perm[p], lu.perm[k]
+			lu.sign = -lu.sign
+		}
+		for i := k + 1; i < n; i++ {
+			a[i*n+k] /= a[k*n+k]
+			f := a[i*n+k]
+			for j := k + 1; j < n; j++ {
+				a[i*n+j] -= f * a[k*n+j]
+			}
+		}
+	}
+	return lu
+}
+
+// singular reports whether a pivot is zero or negligible next to the largest entry of
+// the row it came from, so that solving would only amplify rounding errors. Scaling per
+// row keeps a matrix such as diag(1e-13, 1) regular.
+func (lu *luDecomposition) singular() bool {
+	for k := 0; k < lu.n; k++ {
+		if math.Abs(lu.data[k*lu.n+k]) <= singularTolerance*lu.rowScale[lu.perm[k]] {
+			return true
+		}
+	}
+	return false
+}
+
+// solve solves L × U × x = P × b by forward and back substitution
+func (lu *luDecomposition) solve(b []float64) []float64 {
+	n := lu.n
+	a := lu.data
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[i] = b[lu.perm[i]]
+		for j := 0; j < i; j++ {
+			x[i] -= a[i*n+j] * x[j]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= a[i*n+j] * x[j]
+		}
+		x[i] /= a[i*n+i]
+	}
+	return x
+}
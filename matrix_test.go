@@ -0,0 +1,140 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func mustMatrix(t *testing.T, rows [][]float64) *Matrix {
+	t.Helper()
+	m, err := NewMatrixFromRows(rows)
+	if err != nil {
+		t.Fatalf("NewMatrixFromRows() error = %v", err)
+	}
+	return m
+}
+
+func matrixEqual(m *Matrix, rows [][]float64, tol float64) bool {
+	if m.Rows() != len(rows) {
+		return false
+	}
+	for i, row := range rows {
+		if !floatsEqual(m.ToRows()[i], row, tol) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMatrix_Basics(t *testing.T) {
+	m := NewMatrix(2, 3)
+	m.Set(1, 2, 7)
+	if m.Rows() != 2 || m.Cols() != 3 || m.At(1, 2) != 7 || m.At(0, 0) != 0 {
+		t.Errorf("unexpected matrix %v", m.ToRows())
+	}
+	if !matrixEqual(IdentityMatrix(2), [][]float64{{1, 0}, {0, 1}}, 0) {
+		t.Errorf("IdentityMatrix(2) = %v", IdentityMatrix(2).ToRows())
+	}
+	if _, err := NewMatrixFromRows([][]float64{{1, 2}, {3}}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("NewMatrixFromRows() error = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestMatrix_AddMulTranspose(t *testing.T) {
+	a := mustMatrix(t, [][]float64{{1, 2, 3}, {4, 5, 6}})
+	b := mustMatrix(t, [][]float64{{7, 8}, {9, 10}, {11, 12}})
+
+	sum, err := a.Add(a)
+	if err != nil || !matrixEqual(sum, [][]float64{{2, 4, 6}, {8, 10, 12}}, 0) {
+		t.Errorf("Add() = %v, %v", sum, err)
+	}
+	if _, err := a.Add(b); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Add() error = %v, want ErrDimensionMismatch", err)
+	}
+
+	product, err := a.Mul(b)
+	if err != nil || !matrixEqual(product, [][]float64{{58, 64}, {139, 154}}, 0) {
+		t.Errorf("Mul() = %v, %v", product, err)
+	}
+	if _, err := a.Mul(a); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Mul() error = %v, want ErrDimensionMismatch", err)
+	}
+
+	if !matrixEqual(a.Transpose(), [][]float64{{1, 4}, {2, 5}, {3, 6}}, 0) {
+		t.Errorf("Transpose() = %v", a.Transpose().ToRows())
+	}
+}
+
+func TestMatrix_Determinant(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     [][]float64
+		expected float64
+	}{
+		{"2x2", [][]float64{{4, 6}, {3, 8}}, 14},
+		{"3x3", [][]float64{{6, 1, 1}, {4, -2, 5}, {2, 8, 7}}, -306},
+		{"needs pivot", [][]float64{{0, 1}, {1, 0}}, -1},
+		{"singular", [][]float64{{1, 2}, {2, 4}}, 0},
+		{"zero column", [][]float64{{0, 1, 2}, {0, 3, 4}, {0, 5, 6}}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustMatrix(t, tt.rows).Determinant()
+			if err != nil || math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Determinant() = %v, %v, want %v", got, err, tt.expected)
+			}
+		})
+	}
+
+	if got, err := mustMatrix(t, [][]float64{{1e-13, 0}, {0, 1}}).Determinant(); err != nil || got != 1e-13 {
+		t.Errorf("Determinant() of diag(1e-13, 1) = %v, %v, want 1e-13", got, err)
+	}
+	if _, err := NewMatrix(2, 3).Determinant(); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Determinant() error = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestMatrix_Inverse(t *testing.T) {
+	m := mustMatrix(t, [][]float64{{4, 7}, {2, 6}})
+	inv, err := m.Inverse()
+	if err != nil || !matrixEqual(inv, [][]float64{{0.6, -0.7}, {-0.2, 0.4}}, 1e-12) {
+		t.Fatalf("Inverse() = %v, %v", inv, err)
+	}
+	identity, _ := m.Mul(inv)
+	if !matrixEqual(identity, IdentityMatrix(2).ToRows(), 1e-12) {
+		t.Errorf("m × Inverse() = %v, want identity", identity.ToRows())
+	}
+
+	if _, err := mustMatrix(t, [][]float64{{1, 2}, {2, 4}}).Inverse(); !errors.Is(err, ErrSingularMatrix) {
+		t.Errorf("Inverse() error = %v, want ErrSingularMatrix", err)
+	}
+}
+
+func TestMatrix_Solve(t *testing.T) {
+	m := mustMatrix(t, [][]float64{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}})
+	x, err := m.Solve([]float64{8, -11, -3})
+	if err != nil || !floatsEqual(x, []float64{2, 3, -1}, 1e-12) {
+		t.Errorf("Solve() = %v, %v, want [2 3 -1]", x, err)
+	}
+	if _, err := m.Solve([]float64{1, 2}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Solve() error = %v, want ErrDimensionMismatch", err)
+	}
+	if _, err := NewMatrix(2, 2).Solve([]float64{1, 2}); !errors.Is(err, ErrSingularMatrix) {
+		t.Errorf("Solve() error = %v, want ErrSingularMatrix", err)
+	}
+
+	// small but well-conditioned entries are not singular
+	small := mustMatrix(t, [][]float64{{1e-13, 0}, {0, 1}})
+	if x, err := small.Solve([]float64{2e-13, 3}); err != nil || !floatsEqual(x, []float64{2, 3}, 1e-12) {
+		t.Errorf("Solve() of diag(1e-13, 1) = %v, %v, want [2 3]", x, err)
+	}
+	if _, err := small.Inverse(); err != nil {
+		t.Errorf("Inverse() of diag(1e-13, 1) error = %v", err)
+	}
+	nearly := mustMatrix(t, [][]float64{{1, 1}, {1, 1 + 1e-15}})
+	if _, err := nearly.Solve([]float64{1, 2}); !errors.Is(err, ErrSingularMatrix) {
+		t.Errorf("Solve() of a nearly singular matrix error = %v, want ErrSingularMatrix", err)
+	}
+}
@@ -0,0 +1,131 @@
+package mathx
+
+import "math"
+
+// MAE returns the mean absolute error between actual and predicted values.
+// It returns ErrEmptyInput for empty input and ErrLengthMismatch if the lengths differ.
+func MAE(actual, predicted []float64) (float64, error) {
+	if err := validatePair(actual, predicted); err != nil {
+		return 0, err
+	}
+	var sum compensatedSum
+	for i, a := range actual {
+		sum.Add(math.Abs(a - predicted[i]))
+	}
+	return sum.Value() / float64(len(actual)), nil
+}
+
+// MSE returns the mean squared error between actual and predicted values.
+// It returns ErrEmptyInput for empty input and ErrLengthMismatch if the lengths differ.
+func MSE(actual, predicted []float64) (float64, error) {
+	if err := validatePair(actual, predicted); err != nil {
+		return 0, err
+	}
+	var sum compensatedSum
+	for i, a := range actual {
+		diff := a - predicted[i]
+		sum.Add(diff * diff)
+	}
+	return sum.Value() / float64(len(actual)), nil
+}
+
+// RMSE returns the root mean squared error between actual and predicted values
+func RMSE(actual, predicted []float64) (float64, error) {
+	mse, err := MSE(actual, predicted)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(mse), nil
+}
+
+// MAPE returns the mean absolute percentage error between actual and predicted values
+// in percent, e.g. 12.5 for 12.5%. It returns ErrZeroBase if an actual value is zero.
+func MAPE(actual, predicted []float64) (float64, error) {
+	if err := validatePair(actual, predicted); err != nil {
+		return 0, err
+	}
+	var sum compensatedSum
+	for i, a := range actual {
+		if a == 0 {
+			return 0, ErrZeroBase
+		}
+		sum.Add(math.Abs((a - predicted[i]) / a))
+	}
+	return 100 * sum.Value() / float64(len(actual)), nil
+}
+
+// EuclideanDistance returns the Euclidean (L2) distance between vectors a and b, or
+// ErrLengthMismatch if their lengths differ. The squares are accumulated relative to
+// the largest difference, so components such as 1e200 do not overflow.
+func EuclideanDistance(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, ErrLengthMismatch
+	}
+	scale, ssq := 0.0, 1.0
+	for i, x := range a {
+		d := math.Abs(x - b[i])
+		switch {
+		case math.IsNaN(d) || math.IsInf(d, 0):
+			return d, nil
+		case d == 0:
+			continue
+		case d > scale:
+			ssq = 1 + ssq*(scale/d)*(scale/d)
+			scale = d
+		default:
+			ssq += (d / scale) * (d / scale)
+		}
+	}
+	return scale * math.Sqrt(ssq), nil
+}
+
+// ManhattanDistance returns the Manhattan (L1) distance between vectors a and b, or
+// ErrLengthMismatch if their lengths differ. The differences are summed with
+// compensation, so small components are not lost next to large ones.
+func ManhattanDistance(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, ErrLengthMismatch
+	}
+	var sum compensatedSum
+	for i, x := range a {
+		sum.Add(math.Abs(x - b[i]))
+	}
+	return sum.Value(), nil
+}
+
+// validatePair checks that a and b are non-empty and of equal length
+func validatePair(a, b []float64) error {
+	if len(a) != len(b) {
+		return ErrLengthMismatch
+	}
+	if len(a) == 0 {
+		return ErrEmptyInput
+	}
+	return nil
+}
+
+// compensatedSum accumulates float64 values with Neumaier's variant of Kahan
+// summation, which keeps the rounding error of each addition in a correction term
+type compensatedSum struct {
+	sum, c float64
+}
+
+// Add adds v to the sum
+func (s *compensatedSum) Add(v float64) {
+	t := s.sum + v
+	if math.Abs(s.sum) >= math.Abs(v) {
+		s.c += (s.sum - t) + v
+	} else {
+		s.c += (v - t) + s.sum
+	}
+	s.sum = t
+}
+
+// Value returns the compensated sum
+func (s *compensatedSum) Value() float64 {
+	if math.IsInf(s.sum, 0) || math.IsNaN(s.sum) {
+		// the correction term is meaningless once the sum is not finite
+		return s.sum
+	}
+	return s.sum + s.c
+}
@@ -0,0 +1,100 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestErrorMetrics(t *testing.T) {
+	actual := []float64{3, -0.5, 2, 7}
+	predicted := []float64{2.5, 0, 2, 8}
+
+	tests := []struct {
+		name     string
+		metric   func(a, p []float64) (float64, error)
+		expected float64
+	}{
+		{"MAE", MAE, 0.5},
+		{"MSE", MSE, 0.375},
+		{"RMSE", RMSE, math.Sqrt(0.375)},
+		{"MAPE", MAPE, (100.0/6 + 100 + 0 + 100.0/7) / 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.metric(actual, predicted)
+			if err != nil || math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("%s() = %v, %v, want %v, nil", tt.name, got, err, tt.expected)
+			}
+			if _, err := tt.metric(actual, predicted[:2]); !errors.Is(err, ErrLengthMismatch) {
+				t.Errorf("%s() error = %v, want ErrLengthMismatch", tt.name, err)
+			}
+			if _, err := tt.metric(nil, nil); !errors.Is(err, ErrEmptyInput) {
+				t.Errorf("%s() error = %v, want ErrEmptyInput", tt.name, err)
+			}
+		})
+	}
+
+	if _, err := MAPE([]float64{1, 0}, []float64{1, 1}); !errors.Is(err, ErrZeroBase) {
+		t.Errorf("MAPE() error = %v, want ErrZeroBase", err)
+	}
+}
+
+func TestDistances(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      []float64
+		euclidean float64
+		manhattan float64
+	}{
+		{"3-4-5", []float64{0, 0}, []float64{3, 4}, 5, 7},
+		{"negative", []float64{1, -2, 3}, []float64{-1, 2, 3}, math.Sqrt(20), 6},
+		{"equal", []float64{1, 2}, []float64{1, 2}, 0, 0},
+		{"empty", nil, nil, 0, 0},
+		{"large", []float64{3e200, 0}, []float64{0, 4e200}, 5e200, 7e200},
+		{"small", []float64{3e-200}, []float64{-1e-200}, 4e-200, 4e-200},
+		{"infinite", []float64{math.Inf(1), 0}, []float64{0, 1}, math.Inf(1), math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EuclideanDistance(tt.a, tt.b)
+			if err != nil || !closeRel(got, tt.euclidean) {
+				t.Errorf("EuclideanDistance() = %v, %v, want %v, nil", got, err, tt.euclidean)
+			}
+			got, err = ManhattanDistance(tt.a, tt.b)
+			if err != nil || !closeRel(got, tt.manhattan) {
+				t.Errorf("ManhattanDistance() = %v, %v, want %v, nil", got, err, tt.manhattan)
+			}
+		})
+	}
+
+	if _, err := EuclideanDistance([]float64{1}, nil); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("EuclideanDistance() error = %v, want ErrLengthMismatch", err)
+	}
+	if _, err := ManhattanDistance([]float64{1}, nil); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("ManhattanDistance() error = %v, want ErrLengthMismatch", err)
+	}
+	if got, _ := EuclideanDistance([]float64{math.NaN()}, []float64{0}); !math.IsNaN(got) {
+		t.Errorf("EuclideanDistance() with NaN = %v, want NaN", got)
+	}
+}
+
+// closeRel reports whether got is within a relative 1e-12 of want
+func closeRel(got, want float64) bool {
+	if got == want {
+		return true
+	}
+	return math.Abs(got-want) <= 1e-12*math.Abs(want)
+}
+
+func TestCompensatedSum(t *testing.T) {
+	var s compensatedSum
+	for _, v := range []float64{1, 1e100, 1, -1e100} {
+		s.Add(v)
+	}
+	if got := s.Value(); got != 2 {
+		t.Errorf("compensatedSum = %v, want 2", got)
+	}
+}
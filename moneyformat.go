@@ -0,0 +1,107 @@
+package mathx
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MoneyFormat describes how a money amount is written: the currency symbol and its
+// placement, the group and decimal separators, and the number of decimal places.
+// Empty separators default to "," and ".", so the zero value formats like FormatMoney
+// with no symbol and no decimals.
+type MoneyFormat struct {
+	Symbol           string // currency symbol or code, e.g. "$", "€" or "USD"
+	SymbolAfter      bool   // write the symbol after the number
+	SymbolSpace      bool   // separate symbol and number with a space
+	GroupSeparator   string // thousands separator, "," when empty
+	DecimalSeparator string // decimal separator, "." when empty
+	Places           int32  // decimal places
+	GroupSize        int    // digits per group, 3 when zero
+}
+
+// moneyFormats are the locale presets, keyed by lower-case BCP 47 tag
+var moneyFormats = map[string]MoneyFormat{
+	"en-us": {Symbol: "$", Places: 2},
+	"en-gb": {Symbol: "£", Places: 2},
+	"en-ie": {Symbol: "€", Places: 2},
+	"de-de": {Symbol: "€", SymbolAfter: true, SymbolSpace: true, GroupSeparator: ".", DecimalSeparator: ",", Places: 2},
+	"de-ch": {Symbol: "CHF", SymbolSpace: true, GroupSeparator: "'", Places: 2},
+	"es-es": {Symbol: "€", SymbolAfter: true, SymbolSpace: true, GroupSeparator: ".", DecimalSeparator: ",", Places: 2},
+	"fr-fr": {Symbol: "€", SymbolAfter: true, SymbolSpace: true, GroupSeparator: " ", DecimalSeparator: ",", Places: 2},
+	"it-it": {Symbol: "€", SymbolAfter: true, SymbolSpace: true, GroupSeparator: ".", DecimalSeparator: ",", Places: 2},
+	"nl-nl": {Symbol: "€", SymbolSpace: true, GroupSeparator: ".", DecimalSeparator: ",", Places: 2},
+	"pt-br": {Symbol: "R$", SymbolSpace: true, GroupSeparator: ".", DecimalSeparator: ",", Places: 2},
+	"ja-jp": {Symbol: "¥", Places: 0},
+	"zh-cn": {Symbol: "¥", Places: 2},
+	"ko-kr": {Symbol: "₩", Places: 0},
+}
+
+// LocaleMoneyFormat returns the money format preset for a locale such as "en-US" or "fr_FR".
+// The lookup is case-insensitive and accepts "_" in place of "-".
+func LocaleMoneyFormat(locale string) (MoneyFormat, bool) {
+	f, ok := moneyFormats[strings.ToLower(strings.ReplaceAll(locale, "_", "-"))]
+	return f, ok
+}
+
+// CurrencyCodeFormat returns a format that prefixes the amount with the currency code and
+// uses the currency's minor units, e.g. "USD 1,234.56". It returns ErrUnknownCurrency
+// if code is not registered.
+func CurrencyCodeFormat(code string) (MoneyFormat, error) {
+	c, ok := LookupCurrency(code)
+	if !ok {
+		return MoneyFormat{}, ErrUnknownCurrency
+	}
+	return MoneyFormat{Symbol: c.Code, SymbolSpace: true, Places: c.MinorUnits}, nil
+}
+
+// Format formats amount according to f, e.g. "$1,234.56" or "1 234,56 €"
+func (f MoneyFormat) Format(amount float64) string {
+	var buf [64]byte
+	return string(f.Append(buf[:0], amount))
+}
+
+// FormatSafe formats a decimal amount according to f
+func (f MoneyFormat) FormatSafe(amount decimal.Decimal) string {
+	var buf [64]byte
+	var num [64]byte
+	return string(f.appendNumber(buf[:0], appendMoneyDecimal(num[:0], amount, f.Places, f.grouping())))
+}
+
+// Append appends amount formatted according to f to dst and returns the extended buffer
+func (f MoneyFormat) Append(dst []byte, amount float64) []byte {
+	var num [64]byte
+	return f.appendNumber(dst, AppendMoneyGrouped(num[:0], amount, f.Places, f.grouping()))
+}
+
+// grouping returns the Grouping for f's separators and group size
+func (f MoneyFormat) grouping() Grouping {
+	g := Grouping{Separator: f.GroupSeparator, Size: f.GroupSize, Decimal: f.DecimalSeparator}
+	if g.Separator == "" {
+		g.Separator = ","
+	}
+	return g
+}
+
+// appendNumber appends the output of AppendMoneyGrouped with f's symbol.
+// A minus sign always leads, e.g. "-$1.00" and "-1,00 €".
+func (f MoneyFormat) appendNumber(dst, num []byte) []byte {
+	if len(num) > 0 && num[0] == '-' {
+		dst = append(dst, '-')
+		num = num[1:]
+	}
+	if !f.SymbolAfter && f.Symbol != "" {
+		dst = append(dst, f.Symbol...)
+		if f.SymbolSpace {
+			dst = append(dst, ' ')
+		}
+	}
+	dst = append(dst, num...)
+	if f.SymbolAfter && f.Symbol != "" {
+		if f.SymbolSpace {
+			dst = append(dst, ' ')
+		}
+		dst = append(dst, f.Symbol...)
+	}
+	return dst
+}
@@ -0,0 +1,84 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestLocaleMoneyFormat(t *testing.T) {
+	tests := []struct {
+		locale   string
+		amount   float64
+		expected string
+	}{
+		{"en-US", 1234.56, "$1,234.56"},
+		{"en-US", -1234.56, "-$1,234.56"},
+		{"fr-FR", 1234.56, "1 234,56 €"},
+		{"fr_fr", -1234567.891, "-1 234 567,89 €"},
+		{"de-DE", 1234.5, "1.234,50 €"},
+		{"de-CH", 1234.5, "CHF 1'234.50"},
+		{"pt-BR", 0.5, "R$ 0,50"},
+		{"ja-JP", 1234.5, "¥1,235"},
+		{"en-GB", 0, "£0.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			f, ok := LocaleMoneyFormat(tt.locale)
+			if !ok {
+				t.Fatalf("LocaleMoneyFormat(%q) not found", tt.locale)
+			}
+			if got := f.Format(tt.amount); got != tt.expected {
+				t.Errorf("Format(%v) = %q, want %q", tt.amount, got, tt.expected)
+			}
+		})
+	}
+
+	if _, ok := LocaleMoneyFormat("xx-XX"); ok {
+		t.Error("LocaleMoneyFormat(xx-XX) should not be found")
+	}
+}
+
+func TestCurrencyCodeFormat(t *testing.T) {
+	f, err := CurrencyCodeFormat("usd")
+	if err != nil {
+		t.Fatalf("CurrencyCodeFormat() error = %v", err)
+	}
+	if got := f.Format(1234.56); got != "USD 1,234.56" {
+		t.Errorf("Format() = %q, want %q", got, "USD 1,234.56")
+	}
+
+	f, _ = CurrencyCodeFormat("JPY")
+	if got := f.Format(-1234.5); got != "-JPY 1,235" {
+		t.Errorf("Format() = %q, want %q", got, "-JPY 1,235")
+	}
+
+	if _, err := CurrencyCodeFormat("ZZZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("CurrencyCodeFormat() error = %v, want ErrUnknownCurrency", err)
+	}
+}
+
+func TestMoneyFormatCustom(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   MoneyFormat
+		amount   string
+		expected string
+	}{
+		{"zero value", MoneyFormat{}, "1234.5", "1,235"},
+		{"suffix without space", MoneyFormat{Symbol: "kr", SymbolAfter: true, Places: 2}, "99.999", "100.00kr"},
+		{"multi-byte separator", MoneyFormat{GroupSeparator: " ", DecimalSeparator: ",", Places: 1}, "1234567.25", "1 234 567,3"},
+		{"precise decimal", MoneyFormat{Symbol: "$", Places: 2}, "12345678901234567.895", "$12,345,678,901,234,567.90"},
+		{"group size", MoneyFormat{GroupSeparator: "'", GroupSize: 4}, "123456789", "1'2345'6789"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.format.FormatSafe(decimal.RequireFromString(tt.amount)); got != tt.expected {
+				t.Errorf("FormatSafe() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package mathx
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// Option configures how NewResultWith builds a Result
+type Option func(*resultOptions)
+
+// resultOptions collects the options passed to NewResultWith
+type resultOptions struct {
+	precision    int32
+	hasPrecision bool
+	rounding     RoundingMode
+	hasRounding  bool
+	exact        bool
+}
+
+// WithPrecision rounds the value to precision decimal places
+func WithPrecision(precision int32) Option {
+	return func(o *resultOptions) {
+		o.precision, o.hasPrecision = precision, true
+	}
+}
+
+// WithRounding sets the rounding mode used with WithPrecision. Without it the
+// package default from SetDefaults applies.
+func WithRounding(mode RoundingMode) Option {
+	return func(o *resultOptions) {
+		o.rounding, o.hasRounding = mode, true
+	}
+}
+
+// WithContext takes precision and rounding mode from c
+func WithContext(c Context) Option {
+	return func(o *resultOptions) {
+		WithPrecision(c.Precision)(o)
+		WithRounding(c.Rounding)(o)
+	}
+}
+
+// WithExactString keeps the exact binary value of the float64, as printed by
+// ExactString, instead of its shortest decimal digits: 0.1 becomes
+// 0.1000000000000000055511151231257827021181583404541015625
+func WithExactString() Option {
+	return func(o *resultOptions) {
+		o.exact = true
+	}
+}
+
+// NewResultWith creates a Result from a float64 configured by opts. Options are
+// applied in order, so later ones override earlier ones. Without options it is
+// equivalent to NewResult, and like NewResult it panics for NaN and ±Inf.
+func NewResultWith(value float64, opts ...Option) Result {
+	var o resultOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var d decimal.Decimal
+	if o.exact {
+		d = decimal.RequireFromString(ExactString(value))
+	} else {
+		d = decimal.NewFromFloat(value)
+	}
+	if o.hasPrecision {
+		mode := o.rounding
+		if !o.hasRounding {
+			mode = DefaultRoundingMode()
+		}
+		d = roundDecimal(d, o.precision, mode)
+	}
+	return Result{v: d}
+}
@@ -0,0 +1,38 @@
+package mathx
+
+import "testing"
+
+func TestNewResultWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		opts     []Option
+		expected string
+	}{
+		{"no options", 0.1, nil, "0.1"},
+		{"precision", 2.345, []Option{WithPrecision(2)}, "2.35"},
+		{"precision and rounding", 2.345, []Option{WithPrecision(2), WithRounding(RoundHalfEven)}, "2.34"},
+		{"rounding without precision", 2.345, []Option{WithRounding(RoundDown)}, "2.345"},
+		{"exact", 0.1, []Option{WithExactString()}, "0.1000000000000000055511151231257827021181583404541015625"},
+		{"exact then rounded", 1.005, []Option{WithExactString(), WithPrecision(2)}, "1"},
+		{"shortest then rounded", 1.005, []Option{WithPrecision(2)}, "1.01"},
+		{"context", -2.5, []Option{WithContext(NewContext(0, RoundFloor))}, "-3"},
+		{"later overrides", 2.5, []Option{WithContext(NewContext(0, RoundFloor)), WithRounding(RoundHalfEven)}, "2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewResultWith(tt.value, tt.opts...).String(); got != tt.expected {
+				t.Errorf("NewResultWith() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewResultWith_defaultRounding(t *testing.T) {
+	t.Cleanup(ResetDefaults)
+	SetDefaults(16, RoundHalfEven, 1e-9)
+	if got := NewResultWith(0.125, WithPrecision(2)).String(); got != "0.12" {
+		t.Errorf("NewResultWith() = %v, want 0.12", got)
+	}
+}
@@ -0,0 +1,93 @@
+package mathx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Alignment selects where Pad places text within its width
+type Alignment int
+
+const (
+	AlignRight  Alignment = iota // pad on the left, the usual choice for numbers
+	AlignLeft                    // pad on the right
+	AlignCenter                  // pad on both sides, the extra pad character on the right
+)
+
+// String returns the name of the alignment
+func (a Alignment) String() string {
+	switch a {
+	case AlignRight:
+		return "right"
+	case AlignLeft:
+		return "left"
+	case AlignCenter:
+		return "center"
+	default:
+		return "unknown"
+	}
+}
+
+// ToStringPadded formats value with fixed decimal places and right-aligns it in a field
+// of width characters filled with padChar, e.g. "   12.50". Padding with '0' goes after
+// the minus sign ("-0012.50"). Values wider than width are returned unpadded.
+func ToStringPadded(value float64, places int32, width int, padChar rune) string {
+	var buf [64]byte
+	return padNumber(AppendFixed(buf[:0], value, places), width, padChar)
+}
+
+// ToStringPadded formats r with fixed decimal places, right-aligned as by ToStringPadded
+func (r Result) ToStringPadded(places int32, width int, padChar rune) string {
+	return padNumber([]byte(r.v.StringFixed(places)), width, padChar)
+}
+
+// Pad aligns s in a field of width characters filled with padChar. Width counts runes,
+// so symbols such as "€" take one column. s is returned unchanged if it is wider.
+func Pad(s string, width int, padChar rune, align Alignment) string {
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return s
+	}
+	left := 0
+	switch align {
+	case AlignRight:
+		left = n
+	case AlignCenter:
+		left = n / 2
+	}
+	fill := string(padChar)
+	var b strings.Builder
+	b.Grow(len(s) + n*len(fill))
+	for i := 0; i < left; i++ {
+		b.WriteString(fill)
+	}
+	b.WriteString(s)
+	for i := left; i < n; i++ {
+		b.WriteString(fill)
+	}
+	return b.String()
+}
+
+// padNumber right-aligns a formatted number, keeping a leading minus sign in front of
+// zero padding
+func padNumber(num []byte, width int, padChar rune) string {
+	if padChar == '0' && len(num) > 0 && num[0] == '-' {
+		return "-" + Pad(string(num[1:]), width-1, padChar, AlignRight)
+	}
+	return Pad(string(num), width, padChar, AlignRight)
+}
+
+// FormatColumn formats values with fixed decimal places and right-aligns them to the
+// widest one, so that the decimal points line up when the strings are printed one per row
+func FormatColumn(values []float64, places int32) []string {
+	column := make([]string, len(values))
+	width := 0
+	for i, v := range values {
+		column[i] = ToStringFixed(v, places)
+		width = max(width, len(column[i]))
+	}
+	for i, s := range column {
+		column[i] = Pad(s, width, ' ', AlignRight)
+	}
+	return column
+}
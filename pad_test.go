@@ -0,0 +1,98 @@
+package mathx
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestToStringPadded(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		places   int32
+		width    int
+		padChar  rune
+		expected string
+	}{
+		{"spaces", 12.5, 2, 8, ' ', "   12.50"},
+		{"negative spaces", -12.5, 2, 8, ' ', "  -12.50"},
+		{"zeros", 12.5, 2, 8, '0', "00012.50"},
+		{"negative zeros", -12.5, 2, 8, '0', "-0012.50"},
+		{"rounded", 2.345, 2, 6, '*', "**2.35"},
+		{"exact width", 1234.5, 1, 6, ' ', "1234.5"},
+		{"too wide", 1234.5, 2, 4, ' ', "1234.50"},
+		{"zero width", 7, 0, 0, ' ', "7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToStringPadded(tt.value, tt.places, tt.width, tt.padChar); got != tt.expected {
+				t.Errorf("ToStringPadded() = %q, want %q", got, tt.expected)
+			}
+			r := NewResultFromDecimal(decimal.NewFromFloat(tt.value))
+			if got := r.ToStringPadded(tt.places, tt.width, tt.padChar); got != tt.expected {
+				t.Errorf("Result.ToStringPadded() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		width    int
+		padChar  rune
+		align    Alignment
+		expected string
+	}{
+		{"right", "1.5", 6, ' ', AlignRight, "   1.5"},
+		{"left", "1.5", 6, ' ', AlignLeft, "1.5   "},
+		{"center even", "1.5", 7, '-', AlignCenter, "--1.5--"},
+		{"center odd", "1.5", 6, '-', AlignCenter, "-1.5--"},
+		{"multi-byte text", "€5", 4, ' ', AlignRight, "  €5"},
+		{"multi-byte pad", "5", 3, '·', AlignLeft, "5··"},
+		{"too wide", "12345", 3, ' ', AlignCenter, "12345"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pad(tt.s, tt.width, tt.padChar, tt.align); got != tt.expected {
+				t.Errorf("Pad() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAlignment_String(t *testing.T) {
+	for a, want := range map[Alignment]string{AlignRight: "right", AlignLeft: "left", AlignCenter: "center", Alignment(9): "unknown"} {
+		if got := a.String(); got != want {
+			t.Errorf("Alignment(%d).String() = %q, want %q", a, got, want)
+		}
+	}
+}
+
+func TestFormatColumn(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		places   int32
+		expected []string
+	}{
+		{"mixed widths", []float64{1.5, -1234.567, 20}, 2, []string{"    1.50", "-1234.57", "   20.00"}},
+		{"no decimals", []float64{7, 1000}, 0, []string{"   7", "1000"}},
+		{"single", []float64{3.14159}, 3, []string{"3.142"}},
+		{"empty", nil, 2, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatColumn(tt.values, tt.places)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("FormatColumn() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package mathx
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ParseLocalized parses a number written for locale, the read-side counterpart of
+// LocaleMoneyFormat, e.g. "1.234,56" for "de-DE" or "1 234,56 €" for "fr-FR".
+// It accepts the locale's currency symbol, any kind of space as a space group
+// separator, Arabic-Indic digits with the Arabic decimal and thousands separators,
+// and accounting negatives in parentheses such as "(1,234.56)". Grouping is validated
+// as in ParseMoney. It returns an error wrapping ErrInvalidFormat for malformed input
+// or an unknown locale.
+func ParseLocalized(s string, locale string) (Result, error) {
+	f, ok := LocaleMoneyFormat(locale)
+	if !ok {
+		return Result{}, fmt.Errorf("%w: unknown locale %q", ErrInvalidFormat, locale)
+	}
+	group, point := f.GroupSeparator, f.DecimalSeparator
+	if group == "" {
+		group = ","
+	}
+	if point == "" {
+		point = "."
+	}
+
+	str := strings.TrimSpace(s)
+	neg := false
+	if inner, ok := strings.CutPrefix(str, "("); ok {
+		if str, ok = strings.CutSuffix(inner, ")"); !ok {
+			return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+		}
+		neg = true
+	}
+	sign := ""
+	if len(str) > 0 && (str[0] == '-' || str[0] == '+') {
+		sign, str = str[:1], str[1:]
+	}
+	if f.Symbol != "" {
+		str = strings.Replace(str, f.Symbol, "", 1)
+	}
+	str = sign + strings.TrimSpace(str)
+
+	// rewrite to the canonical form ParseMoney reads: ASCII digits, "," and "."
+	var b strings.Builder
+	for _, r := range str {
+		switch {
+		case r >= '٠' && r <= '٩':
+			b.WriteRune('0' + r - '٠')
+		case r >= '۰' && r <= '۹':
+			b.WriteRune('0' + r - '۰')
+		case r == '٫' || string(r) == point:
+			b.WriteByte('.')
+		case r == '٬' || string(r) == group || (group == " " && unicode.IsSpace(r)):
+			b.WriteByte(',')
+		case r == ',' || r == '.':
+			// the separator the locale does not use is not allowed
+			return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	canonical := b.String()
+	if i := strings.IndexByte(canonical, '.'); i >= 0 && strings.ContainsAny(canonical[i+1:], ",.") {
+		return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	res, err := ParseMoney(canonical)
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	if neg {
+		if res.v.Sign() < 0 {
+			return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+		}
+		res = res.Neg()
+	}
+	return res, nil
+}
@@ -0,0 +1,77 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseLocalized(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		locale   string
+		expected string
+	}{
+		{"german", "1.234,56", "de-DE", "1234.56"},
+		{"french space", "1 234,56", "fr-FR", "1234.56"},
+		{"french narrow no-break space", "1 234 567,8", "fr-FR", "1234567.8"},
+		{"french with symbol", "1 234,56 €", "fr-FR", "1234.56"},
+		{"us with symbol", "-$1,234.56", "en-US", "-1234.56"},
+		{"swiss", "CHF 1'234.50", "de-CH", "1234.5"},
+		{"parentheses", "(1,234.56)", "en-US", "-1234.56"},
+		{"parentheses german", "(1.234,56 €)", "de_de", "-1234.56"},
+		{"arabic-indic digits", "١٢٣٤٫٥٦", "en-US", "1234.56"},
+		{"arabic separators", "١٬٢٣٤٫٥", "en-US", "1234.5"},
+		{"extended arabic-indic", "۱۲۳", "de-DE", "123"},
+		{"no grouping", "1234,5", "de-DE", "1234.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLocalized(tt.input, tt.locale)
+			if err != nil {
+				t.Fatalf("ParseLocalized() error = %v", err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("ParseLocalized(%q, %q) = %v, want %v", tt.input, tt.locale, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseLocalized_errors(t *testing.T) {
+	tests := []struct {
+		input  string
+		locale string
+	}{
+		{"1,234.56", "de-DE"},
+		{"1.23,4", "de-DE"},
+		{"(1,234.56", "en-US"},
+		{"(-5)", "en-US"},
+		{"abc", "en-US"},
+		{"1", "xx-XX"},
+	}
+
+	for _, tt := range tests {
+		if _, err := ParseLocalized(tt.input, tt.locale); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseLocalized(%q, %q) error = %v, want ErrInvalidFormat", tt.input, tt.locale, err)
+		}
+	}
+}
+
+func TestParseLocalized_roundTrip(t *testing.T) {
+	for _, locale := range []string{"en-US", "de-DE", "fr-FR", "de-CH", "pt-BR", "ja-JP"} {
+		f, _ := LocaleMoneyFormat(locale)
+		for _, v := range []float64{-1234567.89, 0.5, 1000} {
+			s := f.Format(v)
+			got, err := ParseLocalized(s, locale)
+			if err != nil {
+				t.Errorf("ParseLocalized(%q, %q) error = %v", s, locale, err)
+				continue
+			}
+			if want := Round(v, f.Places); !got.Equal(want) {
+				t.Errorf("ParseLocalized(%q, %q) = %v, want %v", s, locale, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package mathx
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// ParsePercent parses a percentage such as "12.5%" or "-3 %" into a fraction (0.125, -0.03).
+// Thousands separators are accepted as in ParseMoney.
+func ParsePercent(s string) (Result, error) {
+	return parseScaled(s, "%", 2)
+}
+
+// ParsePermille parses a per-mille value such as "12‰" into a fraction (0.012)
+func ParsePermille(s string) (Result, error) {
+	return parseScaled(s, "‰", 3)
+}
+
+// parseScaled strips suffix from s, parses the number and shifts it right by places
+func parseScaled(s, suffix string, places int32) (Result, error) {
+	str, ok := strings.CutSuffix(strings.TrimSpace(s), suffix)
+	if !ok {
+		return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	r, err := ParseMoney(strings.TrimSpace(str))
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return Result{v: r.v.Shift(-places)}, nil
+}
+
+// FormatPercent formats a fraction as a percentage with fixed decimal places,
+// e.g. FormatPercent(0.125, 1) returns "12.5%"
+func FormatPercent(value float64, places int32) string {
+	return formatScaled(value, places, 2, "%")
+}
+
+// FormatPermille formats a fraction as per mille with fixed decimal places,
+// e.g. FormatPermille(0.012, 0) returns "12‰"
+func FormatPermille(value float64, places int32) string {
+	return formatScaled(value, places, 3, "‰")
+}
+
+// formatScaled shifts value left by shift places and formats it with suffix
+func formatScaled(value float64, places, shift int32, suffix string) string {
+	var buf [64]byte
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return string(strconv.AppendFloat(buf[:0], value, 'f', -1, 64)) + suffix
+	}
+	dst := appendFixedDecimal(buf[:0], decimal.NewFromFloat(value).Shift(shift), places)
+	return string(append(dst, suffix...))
+}
+
+// PercentChange returns the signed change from oldValue to newValue in percent of
+// oldValue, (new - old) / |old| * 100, e.g. 80 -> 100 is 25 and 100 -> 80 is -20.
+// The result is asymmetric: swapping the arguments changes its magnitude.
+// It returns ErrZeroBase if oldValue is zero.
+func PercentChange(oldValue, newValue float64) (Result, error) {
+	o := decimal.NewFromFloat(oldValue)
+	if o.IsZero() {
+		return Result{}, ErrZeroBase
+	}
+	diff := decimal.NewFromFloat(newValue).Sub(o)
+	return Result{v: diff.Shift(2).DivRound(o.Abs(), divisionPlaces)}, nil
+}
+
+// PercentDifference returns the symmetric difference between a and b in percent of
+// their mean magnitude, |a - b| / ((|a| + |b|) / 2) * 100, e.g. 80 and 100 differ by
+// 22.2…. It never depends on argument order and is never negative.
+// It returns ErrZeroBase if both values are zero.
+func PercentDifference(a, b float64) (Result, error) {
+	da, db := decimal.NewFromFloat(a), decimal.NewFromFloat(b)
+	sum := da.Abs().Add(db.Abs())
+	if sum.IsZero() {
+		return Result{}, ErrZeroBase
+	}
+	// |a - b| / (sum / 2) * 100 == |a - b| * 200 / sum
+	return Result{v: da.Sub(db).Abs().Mul(decimal.NewFromInt(200)).DivRound(sum, divisionPlaces)}, nil
+}
@@ -0,0 +1,140 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParsePercent(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"12.5%", "0.125"},
+		{"100%", "1"},
+		{"-3 %", "-0.03"},
+		{" 0.01% ", "0.0001"},
+		{"1,250%", "12.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePercent(tt.input)
+			if err != nil {
+				t.Fatalf("ParsePercent() error = %v", err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("ParsePercent(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"12.5", "%", "abc%", "12‰", "1,25%"} {
+		if _, err := ParsePercent(input); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParsePercent(%q) error = %v, want ErrInvalidFormat", input, err)
+		}
+	}
+}
+
+func TestParsePermille(t *testing.T) {
+	got, err := ParsePermille("12‰")
+	if err != nil || got.String() != "0.012" {
+		t.Errorf("ParsePermille() = %v, %v, want 0.012", got, err)
+	}
+	if _, err := ParsePermille("12%"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ParsePermille() error = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	tests := []struct {
+		value    float64
+		places   int32
+		expected string
+	}{
+		{0.125, 1, "12.5%"},
+		{0.125, 0, "13%"},
+		{0.07, 2, "7.00%"},
+		{-0.0005, 2, "-0.05%"},
+		{1, 0, "100%"},
+		{math.NaN(), 2, "NaN%"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatPercent(tt.value, tt.places); got != tt.expected {
+			t.Errorf("FormatPercent(%v, %d) = %q, want %q", tt.value, tt.places, got, tt.expected)
+		}
+	}
+
+	if got := FormatPermille(0.012, 0); got != "12‰" {
+		t.Errorf("FormatPermille() = %q, want %q", got, "12‰")
+	}
+	if got := FormatPermille(0.0125, 1); got != "12.5‰" {
+		t.Errorf("FormatPermille() = %q, want %q", got, "12.5‰")
+	}
+}
+
+func TestPercentRoundTrip(t *testing.T) {
+	for _, v := range []float64{0.125, 0.0001, -0.333, 2.5} {
+		got, err := ParsePercent(FormatPercent(v, 4))
+		if err != nil || got.Float64() != v {
+			t.Errorf("round trip of %v = %v, %v", v, got, err)
+		}
+	}
+}
+
+func TestPercentChange(t *testing.T) {
+	tests := []struct {
+		oldValue, newValue float64
+		expected           string
+	}{
+		{80, 100, "25"},
+		{100, 80, "-20"},
+		{-50, -25, "50"},
+		{-50, 25, "150"},
+		{3, 4, "33.33333333333333333333333333333333"},
+		{7, 7, "0"},
+	}
+
+	for _, tt := range tests {
+		got, err := PercentChange(tt.oldValue, tt.newValue)
+		if err != nil {
+			t.Fatalf("PercentChange() error = %v", err)
+		}
+		if got.String() != tt.expected {
+			t.Errorf("PercentChange(%v, %v) = %v, want %v", tt.oldValue, tt.newValue, got, tt.expected)
+		}
+	}
+
+	if _, err := PercentChange(0, 5); !errors.Is(err, ErrZeroBase) {
+		t.Errorf("PercentChange() error = %v, want ErrZeroBase", err)
+	}
+}
+
+func TestPercentDifference(t *testing.T) {
+	tests := []struct {
+		a, b     float64
+		expected string
+	}{
+		{80, 100, "22.2222222222"},
+		{100, 80, "22.2222222222"},
+		{0, 10, "200"},
+		{5, 5, "0"},
+		{-10, 10, "200"},
+	}
+
+	for _, tt := range tests {
+		got, err := PercentDifference(tt.a, tt.b)
+		if err != nil {
+			t.Fatalf("PercentDifference() error = %v", err)
+		}
+		if got.Round(10).String() != tt.expected {
+			t.Errorf("PercentDifference(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.expected)
+		}
+	}
+
+	if _, err := PercentDifference(0, 0); !errors.Is(err, ErrZeroBase) {
+		t.Errorf("PercentDifference() error = %v, want ErrZeroBase", err)
+	}
+}
@@ -0,0 +1,106 @@
+package mathx
+
+import (
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+// Pipeline records a sequence of Result operations and evaluates them on demand,
+// so a formula such as a pricing rule can be defined once and applied to many inputs
+// with consistent precision. A Pipeline is immutable: every method returns a new
+// Pipeline, so a common prefix can be shared by several formulas.
+type Pipeline struct {
+	steps []func(Result) Result
+}
+
+// NewPipeline creates an empty Pipeline
+func NewPipeline() Pipeline {
+	return Pipeline{}
+}
+
+// then returns a new Pipeline with step appended
+func (p Pipeline) then(step func(Result) Result) Pipeline {
+	return Pipeline{steps: append(slices.Clip(p.steps), step)}
+}
+
+// Add records adding other
+func (p Pipeline) Add(other decimal.Decimal) Pipeline {
+	return p.then(func(r Result) Result { return r.Add(other) })
+}
+
+// Sub records subtracting other
+func (p Pipeline) Sub(other decimal.Decimal) Pipeline {
+	return p.then(func(r Result) Result { return r.Sub(other) })
+}
+
+// Mul records multiplying by other
+func (p Pipeline) Mul(other decimal.Decimal) Pipeline {
+	return p.then(func(r Result) Result { return r.Mul(other) })
+}
+
+// Div records dividing by other with specified precision
+func (p Pipeline) Div(other decimal.Decimal, precision int32) Pipeline {
+	return p.then(func(r Result) Result { return r.Div(other, precision) })
+}
+
+// DivTrunc records a truncating division by other
+func (p Pipeline) DivTrunc(other decimal.Decimal, precision int32) Pipeline {
+	return p.then(func(r Result) Result { return r.DivTrunc(other, precision) })
+}
+
+// Round records rounding to specified precision
+func (p Pipeline) Round(places int32) Pipeline {
+	return p.then(func(r Result) Result { return r.Round(places) })
+}
+
+// Truncate records truncating to specified precision
+func (p Pipeline) Truncate(places int32) Pipeline {
+	return p.then(func(r Result) Result { return r.Truncate(places) })
+}
+
+// Abs records taking the absolute value
+func (p Pipeline) Abs() Pipeline {
+	return p.then(Result.Abs)
+}
+
+// Neg records negating the value
+func (p Pipeline) Neg() Pipeline {
+	return p.then(Result.Neg)
+}
+
+// Apply records a custom transform
+func (p Pipeline) Apply(fn func(decimal.Decimal) decimal.Decimal) Pipeline {
+	return p.then(func(r Result) Result { return r.Apply(fn) })
+}
+
+// Len returns the number of recorded operations
+func (p Pipeline) Len() int {
+	return len(p.steps)
+}
+
+// Eval applies the recorded operations to a single Result
+func (p Pipeline) Eval(r Result) Result {
+	for _, step := range p.steps {
+		r = step(r)
+	}
+	return r
+}
+
+// Run applies the recorded operations to every value and returns the results in order
+func (p Pipeline) Run(values ...float64) []Result {
+	results := make([]Result, len(values))
+	for i, v := range values {
+		results[i] = p.Eval(NewResult(v))
+	}
+	return results
+}
+
+// RunSafe applies the recorded operations to every decimal value and returns the results in order
+func (p Pipeline) RunSafe(ds ...decimal.Decimal) []Result {
+	results := make([]Result, len(ds))
+	for i, d := range ds {
+		results[i] = p.Eval(Result{v: d})
+	}
+	return results
+}
@@ -0,0 +1,69 @@
+package mathx
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPipeline_Run(t *testing.T) {
+	// price * 0.9 discount + 5 shipping, rounded to cents
+	pricing := NewPipeline().
+		Mul(decimal.RequireFromString("0.9")).
+		Add(decimal.NewFromInt(5)).
+		Round(2)
+
+	results := pricing.Run(100, 19.99, 0)
+	expected := []string{"95.00", "22.99", "5.00"}
+	if len(results) != len(expected) {
+		t.Fatalf("Run() returned %d results, want %d", len(results), len(expected))
+	}
+	for i, r := range results {
+		if got := r.ToStringFixed(2); got != expected[i] {
+			t.Errorf("Run()[%d] = %v, want %v", i, got, expected[i])
+		}
+	}
+}
+
+func TestPipeline_RunSafe(t *testing.T) {
+	p := NewPipeline().Div(decimal.NewFromInt(3), 4).Neg()
+	results := p.RunSafe(decimal.NewFromInt(1), decimal.RequireFromString("-6"))
+	if results[0].String() != "-0.3333" || results[1].String() != "2" {
+		t.Errorf("RunSafe() = %v, want [-0.3333 2]", results)
+	}
+}
+
+func TestPipeline_immutable(t *testing.T) {
+	base := NewPipeline().Mul(decimal.NewFromInt(2))
+	plusOne := base.Add(decimal.NewFromInt(1))
+	minusOne := base.Sub(decimal.NewFromInt(1))
+
+	if base.Len() != 1 || plusOne.Len() != 2 || minusOne.Len() != 2 {
+		t.Fatalf("unexpected lengths %d, %d, %d", base.Len(), plusOne.Len(), minusOne.Len())
+	}
+	if got := plusOne.Eval(NewResult(5)).String(); got != "11" {
+		t.Errorf("plusOne.Eval() = %v, want 11", got)
+	}
+	if got := minusOne.Eval(NewResult(5)).String(); got != "9" {
+		t.Errorf("minusOne.Eval() = %v, want 9", got)
+	}
+	if got := base.Eval(NewResult(5)).String(); got != "10" {
+		t.Errorf("base.Eval() = %v, want 10", got)
+	}
+}
+
+func TestPipeline_operations(t *testing.T) {
+	p := NewPipeline().
+		Sub(decimal.NewFromInt(10)).
+		Abs().
+		DivTrunc(decimal.NewFromInt(3), 2).
+		Truncate(1).
+		Apply(func(d decimal.Decimal) decimal.Decimal { return d.Mul(decimal.NewFromInt(10)) })
+
+	if got := p.Eval(NewResult(0)).String(); got != "33" {
+		t.Errorf("Eval() = %v, want 33", got)
+	}
+	if got := NewPipeline().Eval(NewResult(1.5)).String(); got != "1.5" {
+		t.Errorf("empty pipeline Eval() = %v, want 1.5", got)
+	}
+}
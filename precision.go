@@ -0,0 +1,53 @@
+package mathx
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// DecimalPlaces returns the number of digits after the decimal point in the shortest
+// representation of value, e.g. 2 for 12.34 and 0 for 1200. NaN and ±Inf return 0.
+func DecimalPlaces(value float64) int {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0
+	}
+	var buf [32]byte
+	_, exp := floatDigits(buf[:0], value)
+	return int(max(-exp, 0))
+}
+
+// SignificantDigits returns the number of significant digits in the shortest
+// representation of value, e.g. 4 for 12.34, 2 for 1200 and 1 for 0. NaN and ±Inf return 0.
+func SignificantDigits(value float64) int {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0
+	}
+	var buf [32]byte
+	digits, _ := floatDigits(buf[:0], value)
+	return len(digits)
+}
+
+// Decompose splits value into a base-10 mantissa and exponent such that
+// value == mantissa * 10^exponent, with 1 <= |mantissa| < 10. The mantissa holds the
+// shortest digits that represent value exactly as a float64, e.g. 1234.5 gives (1.2345, 3).
+// Zero, NaN and ±Inf give a zero mantissa and exponent.
+func Decompose(value float64) (mantissa Result, exponent int) {
+	if value == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return Result{v: decimal.Zero}, 0
+	}
+	var buf [32]byte
+	digits, exp := floatDigits(buf[:0], value)
+	coef, _ := strconv.ParseInt(string(digits), 10, 64)
+	if value < 0 {
+		coef = -coef
+	}
+	n := int32(len(digits)) - 1
+	return Result{v: decimal.New(coef, -n)}, int(exp + n)
+}
+
+// Compose is the inverse of Decompose and returns mantissa * 10^exponent
+func Compose(mantissa Result, exponent int) Result {
+	return Result{v: mantissa.v.Shift(int32(exponent))}
+}
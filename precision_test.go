@@ -2,6 +2,7 @@ package mathx
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -58,3 +59,62 @@ func TestPrec3(t *testing.T) {
 	sum := d1.Add(d2)
 	fmt.Println("结果:", sum.String())
 }
+
+func TestDecimalPlaces(t *testing.T) {
+	tests := []struct {
+		value       float64
+		places      int
+		significant int
+	}{
+		{12.34, 2, 4},
+		{1200, 0, 2},
+		{0, 0, 1},
+		{-0.005, 3, 1},
+		{0.1, 1, 1},
+		{0.09999999999999998, 17, 16},
+		{123456789.125, 3, 12},
+		{1e-10, 10, 1},
+		{math.NaN(), 0, 0},
+		{math.Inf(1), 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := DecimalPlaces(tt.value); got != tt.places {
+			t.Errorf("DecimalPlaces(%v) = %v, want %v", tt.value, got, tt.places)
+		}
+		if got := SignificantDigits(tt.value); got != tt.significant {
+			t.Errorf("SignificantDigits(%v) = %v, want %v", tt.value, got, tt.significant)
+		}
+	}
+}
+
+func TestDecomposeCompose(t *testing.T) {
+	tests := []struct {
+		value    float64
+		mantissa string
+		exponent int
+	}{
+		{1234.5, "1.2345", 3},
+		{-0.00042, "-4.2", -4},
+		{1, "1", 0},
+		{9.99, "9.99", 0},
+		{6.02214076e23, "6.02214076", 23},
+		{0, "0", 0},
+		{math.MaxFloat64, "1.7976931348623157", 308},
+		{5e-324, "5", -324},
+	}
+
+	for _, tt := range tests {
+		m, e := Decompose(tt.value)
+		if m.String() != tt.mantissa || e != tt.exponent {
+			t.Errorf("Decompose(%v) = (%v, %v), want (%v, %v)", tt.value, m, e, tt.mantissa, tt.exponent)
+		}
+		if got := Compose(m, e).Float64(); got != tt.value {
+			t.Errorf("Compose(Decompose(%v)) = %v", tt.value, got)
+		}
+	}
+
+	if m, e := Decompose(math.NaN()); !m.IsZero() || e != 0 {
+		t.Errorf("Decompose(NaN) = (%v, %v), want (0, 0)", m, e)
+	}
+}
@@ -0,0 +1,68 @@
+package mathx
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// Softmax maps scores to probabilities proportional to exp(score). The maximum score
+// is subtracted first, so large scores do not overflow. +Inf scores share the whole
+// probability; a NaN score makes every output NaN.
+func Softmax(values []float64) []float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	m := math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) {
+			m = v
+			break
+		}
+		m = max(m, v)
+	}
+
+	probs := make([]float64, len(values))
+	var sum float64
+	for i, v := range values {
+		switch {
+		case math.IsNaN(m):
+			probs[i] = m
+			continue
+		case math.IsInf(m, 1):
+			if math.IsInf(v, 1) {
+				probs[i] = 1
+			}
+		case math.IsInf(m, -1):
+			// every score is -Inf, which leaves them all equally likely
+			probs[i] = 1
+		default:
+			probs[i] = math.Exp(v - m)
+		}
+		sum += probs[i]
+	}
+	if math.IsNaN(m) {
+		return probs
+	}
+	for i := range probs {
+		probs[i] /= sum
+	}
+	return probs
+}
+
+// NormalizeToProbabilities scales non-negative weights to probabilities rounded to
+// places decimal places (clamped to [0, 18]) that sum to exactly 1. The rounding
+// leftovers go to the largest remainders as in Apportion, which also defines how
+// negative, NaN and all-zero weights are treated.
+func NormalizeToProbabilities(values []float64, places int32) []Result {
+	if len(values) == 0 {
+		return nil
+	}
+	places = Clamp(places, 0, 18)
+	units := Apportion(pow10Decimal(places).IntPart(), values)
+	probs := make([]Result, len(units))
+	for i, u := range units {
+		probs[i] = Result{v: decimal.New(u, -places)}
+	}
+	return probs
+}
@@ -0,0 +1,80 @@
+package mathx
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestSoftmax(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected []float64
+	}{
+		{"equal", []float64{2, 2}, []float64{0.5, 0.5}},
+		{"ln ratios", []float64{0, math.Log(3)}, []float64{0.25, 0.75}},
+		{"large scores", []float64{1000, 1000 + math.Log(3)}, []float64{0.25, 0.75}},
+		{"very negative", []float64{-1000, 0}, []float64{0, 1}},
+		{"positive infinity", []float64{math.Inf(1), 5, math.Inf(1)}, []float64{0.5, 0, 0.5}},
+		{"all negative infinity", []float64{math.Inf(-1), math.Inf(-1)}, []float64{0.5, 0.5}},
+		{"empty", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Softmax(tt.values)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Softmax() = %v, want %v", got, tt.expected)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.expected[i]) > 1e-12 {
+					t.Errorf("Softmax() = %v, want %v", got, tt.expected)
+					break
+				}
+			}
+		})
+	}
+
+	for _, p := range Softmax([]float64{1, math.NaN()}) {
+		if !math.IsNaN(p) {
+			t.Errorf("Softmax() with NaN = %v, want NaN", p)
+		}
+	}
+}
+
+func TestNormalizeToProbabilities(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		places   int32
+		expected []string
+	}{
+		{"thirds", []float64{1, 1, 1}, 2, []string{"0.34", "0.33", "0.33"}},
+		{"proportional", []float64{1, 3}, 2, []string{"0.25", "0.75"}},
+		{"largest remainder", []float64{2, 3, 5, 1}, 3, []string{"0.182", "0.273", "0.454", "0.091"}},
+		{"zero places", []float64{1, 2}, 0, []string{"0", "1"}},
+		{"all zero", []float64{0, 0}, 1, []string{"0.5", "0.5"}},
+		{"empty", nil, 2, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NormalizeToProbabilities(tt.values, tt.places)
+			strs := make([]string, len(got))
+			sum := decimal.Zero
+			for i, r := range got {
+				strs[i] = r.String()
+				sum = sum.Add(r.Decimal())
+			}
+			if !slices.Equal(strs, tt.expected) {
+				t.Errorf("NormalizeToProbabilities() = %v, want %v", strs, tt.expected)
+			}
+			if len(got) > 0 && !sum.Equal(One) {
+				t.Errorf("NormalizeToProbabilities() sums to %v, want 1", sum)
+			}
+		})
+	}
+}
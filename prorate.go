@@ -0,0 +1,30 @@
+package mathx
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Prorate returns the share of amount that falls in [start, end) when amount covers the
+// whole period [periodStart, periodEnd), i.e. amount * days(overlap) / days(period), with
+// days counted by dayCount. The range is clipped to the period; a range outside the
+// period or an empty period gives zero.
+func Prorate(amount float64, start, end, periodStart, periodEnd time.Time, dayCount DayCount) Result {
+	total := dayCount.Days(periodStart, periodEnd)
+	if total <= 0 {
+		return Result{v: decimal.Zero}
+	}
+	if start.Before(periodStart) {
+		start = periodStart
+	}
+	if end.After(periodEnd) {
+		end = periodEnd
+	}
+	days := dayCount.Days(start, end)
+	if days <= 0 {
+		return Result{v: decimal.Zero}
+	}
+	v := decimal.NewFromFloat(amount).Mul(decimal.NewFromInt(days)).DivRound(decimal.NewFromInt(total), divisionPlaces)
+	return Result{v: v}
+}
@@ -0,0 +1,37 @@
+package mathx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProrate(t *testing.T) {
+	jan1, feb1 := date(2024, 1, 1), date(2024, 2, 1)
+
+	tests := []struct {
+		name       string
+		amount     float64
+		start, end time.Time
+		expected   string
+	}{
+		{"full period", 3100, jan1, feb1, "3100"},
+		{"half month", 3100, date(2024, 1, 17), feb1, "1500"},
+		{"ten days", 3100, date(2024, 1, 11), date(2024, 1, 21), "1000"},
+		{"clipped to period", 3100, date(2023, 12, 1), date(2024, 1, 11), "1000"},
+		{"outside period", 3100, date(2024, 3, 1), date(2024, 4, 1), "0"},
+		{"repeating", 100, jan1, date(2024, 1, 2), "3.22580645161290322580645161290323"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Prorate(tt.amount, tt.start, tt.end, jan1, feb1, Act365Fixed)
+			if got.String() != tt.expected {
+				t.Errorf("Prorate() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if got := Prorate(100, jan1, feb1, feb1, feb1, Act360); !got.IsZero() {
+		t.Errorf("Prorate() over empty period = %v, want 0", got)
+	}
+}
@@ -0,0 +1,67 @@
+package mathx
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRandomMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max float64
+		places   int32
+	}{
+		{"cents", 1, 100, 2},
+		{"negative", -5.5, -0.25, 2},
+		{"whole units", 10, 20, 0},
+		{"tens", 0, 1000, -1},
+		{"reversed", 9.99, 0.01, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi := min(tt.min, tt.max), max(tt.min, tt.max)
+			for _, r := range RandomMoneySlice(500, tt.min, tt.max, tt.places) {
+				f := r.Float64()
+				if f < lo || f > hi {
+					t.Fatalf("RandomMoney() = %v, outside [%v, %v]", r, lo, hi)
+				}
+				if !r.Decimal().Equal(r.Decimal().Round(tt.places)) {
+					t.Fatalf("RandomMoney() = %v, not a multiple of 10^-%d", r, tt.places)
+				}
+			}
+		})
+	}
+
+	if got := RandomMoney(0.121, 0.129, 2).String(); got != "0.12" {
+		t.Errorf("RandomMoney() with no cent in range = %v, want 0.12", got)
+	}
+	if got := RandomMoneySlice(-1, 0, 1, 2); len(got) != 0 {
+		t.Errorf("RandomMoneySlice(-1) = %v, want empty", got)
+	}
+}
+
+func TestRandomMoney_coversRange(t *testing.T) {
+	seen := map[string]bool{}
+	for _, r := range RandomMoneySlice(2000, 0, 0.09, 2) {
+		seen[r.Key()] = true
+	}
+	if len(seen) != 10 {
+		t.Errorf("RandomMoney() produced %d distinct cents, want all 10", len(seen))
+	}
+}
+
+func TestMoneyGenerator(t *testing.T) {
+	a := NewMoneyGenerator(42).MoneySlice(20, -100, 100, 2)
+	b := NewMoneyGenerator(42).MoneySlice(20, -100, 100, 2)
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			t.Fatalf("MoneySlice()[%d] = %v and %v, want equal for the same seed", i, a[i], b[i])
+		}
+	}
+	wide := NewMoneyGenerator(1).Money(-1e300, 1e300, 2)
+	if wide.Decimal().Abs().GreaterThan(decimal.New(1, 300)) {
+		t.Errorf("Money() over a wide range = %v, outside the range", wide)
+	}
+}
@@ -0,0 +1,80 @@
+package mathx
+
+import "github.com/shopspring/decimal"
+
+// Seconds per period used by the per-second rate conversions. A month is an average
+// Gregorian month of 30.436875 days.
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 3600
+	secondsPerDay    = 86400
+	secondsPerMonth  = 2629746
+)
+
+// PerSecondToPerMinute converts a per-second rate (e.g. requests/s) to a per-minute rate
+func PerSecondToPerMinute(rate float64) Result {
+	return Mul(rate, secondsPerMinute)
+}
+
+// PerSecondToPerHour converts a per-second rate to a per-hour rate
+func PerSecondToPerHour(rate float64) Result {
+	return Mul(rate, secondsPerHour)
+}
+
+// PerSecondToPerDay converts a per-second rate to a per-day rate
+func PerSecondToPerDay(rate float64) Result {
+	return Mul(rate, secondsPerDay)
+}
+
+// PerSecondToPerMonth converts a per-second rate to a per-month rate,
+// based on an average Gregorian month of 30.436875 days
+func PerSecondToPerMonth(rate float64) Result {
+	return Mul(rate, secondsPerMonth)
+}
+
+// AnnualizeRate converts a periodic rate (e.g. 0.01 per month) to an annual rate.
+// With compound set the result is (1 + rate)^periodsPerYear - 1, otherwise
+// rate * periodsPerYear. It returns ErrDomain if periodsPerYear is not positive
+// or, when compounding, if rate is not greater than -1.
+func AnnualizeRate(rate float64, periodsPerYear int, compound bool) (Result, error) {
+	if periodsPerYear <= 0 {
+		return Result{}, ErrDomain
+	}
+	r := decimal.NewFromFloat(rate)
+	if !compound {
+		return Result{v: r.Mul(decimal.NewFromInt(int64(periodsPerYear)))}, nil
+	}
+	base := decimalOne.Add(r)
+	if base.Sign() <= 0 {
+		return Result{}, ErrDomain
+	}
+	growth, err := base.PowInt32(int32(periodsPerYear))
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{v: growth.Sub(decimalOne)}, nil
+}
+
+// DeannualizeRate converts an annual rate to the equivalent rate per period.
+// With compound set the result is (1 + annual)^(1/periodsPerYear) - 1, otherwise
+// annual / periodsPerYear. It returns ErrDomain if periodsPerYear is not positive
+// or, when compounding, if annual is not greater than -1.
+func DeannualizeRate(annual float64, periodsPerYear int, compound bool) (Result, error) {
+	if periodsPerYear <= 0 {
+		return Result{}, ErrDomain
+	}
+	r := decimal.NewFromFloat(annual)
+	n := decimal.NewFromInt(int64(periodsPerYear))
+	if !compound {
+		return Result{v: r.DivRound(n, divisionPlaces)}, nil
+	}
+	base := decimalOne.Add(r)
+	if base.Sign() <= 0 {
+		return Result{}, ErrDomain
+	}
+	growth, err := base.PowWithPrecision(decimalOne.DivRound(n, divisionPlaces), divisionPlaces)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{v: growth.Sub(decimalOne)}, nil
+}
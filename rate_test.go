@@ -0,0 +1,110 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPerSecondConversions(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(float64) Result
+		rate     float64
+		expected string
+	}{
+		{"per minute", PerSecondToPerMinute, 2.5, "150"},
+		{"per hour", PerSecondToPerHour, 0.5, "1800"},
+		{"per day", PerSecondToPerDay, 1, "86400"},
+		{"per month", PerSecondToPerMonth, 1, "2629746"},
+		{"fractional", PerSecondToPerMinute, 0.1, "6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(tt.rate).String(); got != tt.expected {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnnualizeRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     float64
+		periods  int
+		compound bool
+		expected string
+	}{
+		{"simple monthly", 0.01, 12, false, "0.12"},
+		{"compound monthly", 0.01, 12, true, "0.126825030131969720661201"},
+		{"compound quarterly", 0.02, 4, true, "0.08243216"},
+		{"single period", 0.05, 1, true, "0.05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AnnualizeRate(tt.rate, tt.periods, tt.compound)
+			if err != nil {
+				t.Fatalf("AnnualizeRate() error = %v", err)
+			}
+			if !got.Decimal().Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("AnnualizeRate() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	errTests := []struct {
+		name     string
+		rate     float64
+		periods  int
+		compound bool
+	}{
+		{"total loss compounded backwards", -1, -1, true},
+		{"zero periods", 0.1, 0, false},
+		{"negative periods", 0.1, -12, false},
+		{"rate below -1", -1.5, 12, true},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := AnnualizeRate(tt.rate, tt.periods, tt.compound); !errors.Is(err, ErrDomain) {
+				t.Errorf("AnnualizeRate() error = %v, want ErrDomain", err)
+			}
+		})
+	}
+}
+
+func TestDeannualizeRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		annual   float64
+		periods  int
+		compound bool
+		expected string
+	}{
+		{"simple monthly", 0.12, 12, false, "0.01"},
+		{"compound quarterly", 0.08243216, 4, true, "0.02"},
+		{"compound monthly", 0.126825030131969720661201, 12, true, "0.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeannualizeRate(tt.annual, tt.periods, tt.compound)
+			if err != nil {
+				t.Fatalf("DeannualizeRate() error = %v", err)
+			}
+			if !got.Round(10).Decimal().Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("DeannualizeRate() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := DeannualizeRate(0.1, 0, true); !errors.Is(err, ErrDomain) {
+		t.Errorf("DeannualizeRate() error = %v, want ErrDomain", err)
+	}
+	if _, err := DeannualizeRate(-1.5, 12, true); !errors.Is(err, ErrDomain) {
+		t.Errorf("DeannualizeRate() error = %v, want ErrDomain", err)
+	}
+}
@@ -0,0 +1,77 @@
+package mathx
+
+import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// Ratio is an exact ratio A:B of two decimals
+type Ratio struct {
+	A, B decimal.Decimal
+}
+
+// NewRatio creates a ratio a:b from float64 values
+func NewRatio(a, b float64) Ratio {
+	return Ratio{A: decimal.NewFromFloat(a), B: decimal.NewFromFloat(b)}
+}
+
+// String returns the ratio as "A:B"
+func (r Ratio) String() string {
+	return r.A.String() + ":" + r.B.String()
+}
+
+// Simplify reduces the ratio to the smallest integer terms, e.g. 1.5:6 becomes 1:4.
+// The sign is carried by A. A ratio with a zero term is returned as 0:1, 1:0 or 0:0.
+func (r Ratio) Simplify() Ratio {
+	switch {
+	case r.A.IsZero() && r.B.IsZero():
+		return Ratio{A: decimal.Zero, B: decimal.Zero}
+	case r.A.IsZero():
+		return Ratio{A: decimal.Zero, B: decimalOne}
+	case r.B.IsZero():
+		return Ratio{A: decimalOne, B: decimal.Zero}
+	}
+	// Bring both terms to integers sharing the smallest exponent
+	exp := min(r.A.Exponent(), r.B.Exponent(), 0)
+	a := r.A.Shift(-exp).BigInt()
+	b := r.B.Shift(-exp).BigInt()
+	if b.Sign() < 0 {
+		a.Neg(a)
+		b.Neg(b)
+	}
+	g := new(big.Int).GCD(nil, nil, new(big.Int).Abs(a), b)
+	return Ratio{A: decimal.NewFromBigInt(a.Quo(a, g), 0), B: decimal.NewFromBigInt(b.Quo(b, g), 0)}
+}
+
+// Scale multiplies both terms of the ratio by factor
+func (r Ratio) Scale(factor decimal.Decimal) Ratio {
+	return Ratio{A: r.A.Mul(factor), B: r.B.Mul(factor)}
+}
+
+// Value returns A/B rounded to precision decimal places, or ErrDivisionByZero if B is zero
+func (r Ratio) Value(precision int32) (Result, error) {
+	if r.B.IsZero() {
+		return Result{}, ErrDivisionByZero
+	}
+	return Result{v: r.A.DivRound(r.B, precision)}, nil
+}
+
+// Equal reports whether two ratios are equivalent, i.e. A1*B2 == A2*B1
+func (r Ratio) Equal(other Ratio) bool {
+	return r.A.Mul(other.B).Equal(other.A.Mul(r.B))
+}
+
+// SolveProportion returns d such that a/b = c/d, i.e. d = b*c/a,
+// rounded to precision decimal places. It returns ErrDivisionByZero if a is zero.
+func SolveProportion(a, b, c float64, precision int32) (Result, error) {
+	return SolveProportionSafe(decimal.NewFromFloat(a), decimal.NewFromFloat(b), decimal.NewFromFloat(c), precision)
+}
+
+// SolveProportionSafe returns d such that a/b = c/d using decimals
+func SolveProportionSafe(a, b, c decimal.Decimal, precision int32) (Result, error) {
+	if a.IsZero() {
+		return Result{}, ErrDivisionByZero
+	}
+	return Result{v: b.Mul(c).DivRound(a, precision)}, nil
+}
@@ -0,0 +1,85 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRatioSimplify(t *testing.T) {
+	tests := []struct {
+		name     string
+		ratio    Ratio
+		expected string
+	}{
+		{"integers", NewRatio(6, 8), "3:4"},
+		{"decimals", NewRatio(1.5, 6), "1:4"},
+		{"mixed exponents", NewRatio(0.25, 0.5), "1:2"},
+		{"large exponent", NewRatio(300, 200), "3:2"},
+		{"negative b", NewRatio(2, -4), "-1:2"},
+		{"both negative", NewRatio(-3, -9), "1:3"},
+		{"already simple", NewRatio(5, 7), "5:7"},
+		{"zero a", NewRatio(0, 5), "0:1"},
+		{"zero b", NewRatio(5, 0), "1:0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ratio.Simplify().String(); got != tt.expected {
+				t.Errorf("Simplify() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRatioScaleValueEqual(t *testing.T) {
+	r := NewRatio(2, 3)
+	if got := r.Scale(decimal.NewFromFloat(1.5)).String(); got != "3:4.5" {
+		t.Errorf("Scale() = %v, want 3:4.5", got)
+	}
+	if !r.Equal(NewRatio(4, 6)) {
+		t.Error("2:3 should equal 4:6")
+	}
+	if r.Equal(NewRatio(3, 2)) {
+		t.Error("2:3 should not equal 3:2")
+	}
+
+	v, err := r.Value(4)
+	if err != nil || v.String() != "0.6667" {
+		t.Errorf("Value() = %v, %v, want 0.6667", v, err)
+	}
+	if _, err := NewRatio(1, 0).Value(2); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Value() error = %v, want ErrDivisionByZero", err)
+	}
+}
+
+func TestSolveProportion(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b, c   float64
+		precision int32
+		expected  string
+	}{
+		{"recipe scaling", 4, 200, 6, 2, "300"},
+		{"mixing", 1, 3, 2.5, 2, "7.5"},
+		{"rounded", 3, 1, 1, 4, "0.3333"},
+		{"exact decimals", 0.1, 0.2, 0.3, 10, "0.6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SolveProportion(tt.a, tt.b, tt.c, tt.precision)
+			if err != nil {
+				t.Fatalf("SolveProportion() error = %v", err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("SolveProportion() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := SolveProportion(0, 1, 1, 2); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("SolveProportion() error = %v, want ErrDivisionByZero", err)
+	}
+}
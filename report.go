@@ -0,0 +1,207 @@
+package mathx
+
+import (
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+// TotalsReport is a table of rounded cells with row, column and grand totals that
+// add up exactly as displayed
+type TotalsReport struct {
+	Cells     [][]decimal.Decimal
+	RowTotals []decimal.Decimal
+	ColTotals []decimal.Decimal
+	Total     decimal.Decimal
+}
+
+// ReportTotals rounds a table to places decimal places so that the displayed cells sum
+// exactly to the displayed row and column totals, and those to the grand total
+// (penny-true reporting). The grand total is the exact total rounded with mode; every
+// cell and subtotal is its exact value rounded down or up to an adjacent multiple of
+// 10^-places, preferring to round up the cells with the largest remainders. Cells
+// already on the grid are kept. It returns ErrDimensionMismatch for ragged rows.
+func ReportTotals(cells [][]decimal.Decimal, places int32, mode RoundingMode) (TotalsReport, error) {
+	rows := len(cells)
+	cols := 0
+	if rows > 0 {
+		cols = len(cells[0])
+	}
+	for _, row := range cells {
+		if len(row) != cols {
+			return TotalsReport{}, ErrDimensionMismatch
+		}
+	}
+
+	// split every cell into its floor on the grid and a remainder in units of 10^-places
+	floors := make([][]decimal.Decimal, rows)
+	rems := make([][]decimal.Decimal, rows)
+	rowRems := make([]decimal.Decimal, rows)
+	colRems := make([]decimal.Decimal, cols)
+	exact, floorSum := decimal.Zero, decimal.Zero
+	for i, row := range cells {
+		floors[i] = make([]decimal.Decimal, cols)
+		rems[i] = make([]decimal.Decimal, cols)
+		for j, x := range row {
+			f := roundDecimal(x, places, RoundFloor)
+			floors[i][j] = f
+			rems[i][j] = x.Sub(f).Shift(places)
+			rowRems[i] = rowRems[i].Add(rems[i][j])
+			colRems[j] = colRems[j].Add(rems[i][j])
+			exact = exact.Add(x)
+			floorSum = floorSum.Add(f)
+		}
+	}
+	total := roundDecimal(exact, places, mode)
+	extra := int(total.Sub(floorSum).Shift(places).IntPart())
+
+	ups := controlledRounding(rems, rowRems, colRems, extra)
+	unit := pow10Decimal(-places)
+	report := TotalsReport{
+		Cells:     floors,
+		RowTotals: make([]decimal.Decimal, rows),
+		ColTotals: make([]decimal.Decimal, cols),
+		Total:     total,
+	}
+	for i := range floors {
+		for j := range floors[i] {
+			if ups[i][j] {
+				floors[i][j] = floors[i][j].Add(unit)
+			}
+			report.RowTotals[i] = report.RowTotals[i].Add(floors[i][j])
+			report.ColTotals[j] = report.ColTotals[j].Add(floors[i][j])
+		}
+	}
+	return report, nil
+}
+
+// controlledRounding chooses which cells to round up so that exactly extra cells are
+// raised, every row raises floor or ceil of its remainder sum and so does every column.
+// It solves this as a circulation with lower bounds: source -> row -> cell column ->
+// sink -> source. The fractional remainders themselves form a feasible circulation,
+// so by integrality an integral one with the required total always exists.
+func controlledRounding(rems [][]decimal.Decimal, rowRems, colRems []decimal.Decimal, extra int) [][]bool {
+	rows, cols := len(rowRems), len(colRems)
+	source, sink := 0, rows+cols+1
+	g := newFlowNetwork(rows + cols + 2)
+	excess := make([]int, rows+cols+2)
+	bounded := func(u, v, lo, hi int) int {
+		excess[u] -= lo
+		excess[v] += lo
+		return g.addEdge(u, v, hi-lo)
+	}
+
+	for i, r := range rowRems {
+		bounded(source, 1+i, int(r.Floor().IntPart()), int(r.Ceil().IntPart()))
+	}
+	cellEdges := make([][]int, rows)
+	for i := range rems {
+		cellEdges[i] = make([]int, cols)
+		// offer the largest remainders first, so the search prefers rounding them up
+		order := make([]int, cols)
+		for j := range order {
+			order[j] = j
+		}
+		slices.SortStableFunc(order, func(a, b int) int { return rems[i][b].Cmp(rems[i][a]) })
+		for _, j := range order {
+			cellEdges[i][j] = -1
+			if !rems[i][j].IsZero() {
+				cellEdges[i][j] = g.addEdge(1+i, 1+rows+j, 1)
+			}
+		}
+	}
+	for j, c := range colRems {
+		bounded(1+rows+j, sink, int(c.Floor().IntPart()), int(c.Ceil().IntPart()))
+	}
+	bounded(sink, source, extra, extra)
+
+	superSource, superSink := g.addNode(), g.addNode()
+	for v, e := range excess {
+		if e > 0 {
+			g.addEdge(superSource, v, e)
+		} else if e < 0 {
+			g.addEdge(v, superSink, -e)
+		}
+	}
+	g.maxFlow(superSource, superSink)
+
+	ups := make([][]bool, rows)
+	for i := range ups {
+		ups[i] = make([]bool, cols)
+		for j, e := range cellEdges[i] {
+			ups[i][j] = e >= 0 && g.flow(e) > 0
+		}
+	}
+	return ups
+}
+
+// flowNetwork is a residual graph for integer max-flow. Edge e and its reverse e^1
+// are stored next to each other.
+type flowNetwork struct {
+	adj [][]int
+	to  []int
+	cap []int
+}
+
+// newFlowNetwork returns a network with n nodes and no edges
+func newFlowNetwork(n int) *flowNetwork {
+	return &flowNetwork{adj: make([][]int, n)}
+}
+
+// addNode adds a node and returns its index
+func (g *flowNetwork) addNode() int {
+	g.adj = append(g.adj, nil)
+	return len(g.adj) - 1
+}
+
+// addEdge adds an edge from u to v with capacity c and returns its index
+func (g *flowNetwork) addEdge(u, v, c int) int {
+	e := len(g.to)
+	g.to = append(g.to, v, u)
+	g.cap = append(g.cap, c, 0)
+	g.adj[u] = append(g.adj[u], e)
+	g.adj[v] = append(g.adj[v], e+1)
+	return e
+}
+
+// flow returns the flow pushed through edge e
+func (g *flowNetwork) flow(e int) int {
+	return g.cap[e^1]
+}
+
+// maxFlow pushes the maximum flow from s to t along shortest augmenting paths
+// (Edmonds-Karp) and returns its value
+func (g *flowNetwork) maxFlow(s, t int) int {
+	total := 0
+	via := make([]int, len(g.adj))
+	for {
+		for i := range via {
+			via[i] = -1
+		}
+		queue := []int{s}
+		for len(queue) > 0 && via[t] < 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, e := range g.adj[u] {
+				if v := g.to[e]; g.cap[e] > 0 && v != s && via[v] < 0 {
+					via[v] = e
+					queue = append(queue, v)
+				}
+			}
+		}
+		if via[t] < 0 {
+			return total
+		}
+		f := -1
+		for v := t; v != s; v = g.to[via[v]^1] {
+			if c := g.cap[via[v]]; f < 0 || c < f {
+				f = c
+			}
+		}
+		for v := t; v != s; v = g.to[via[v]^1] {
+			g.cap[via[v]] -= f
+			g.cap[via[v]^1] += f
+		}
+		total += f
+	}
+}
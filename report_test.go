@@ -0,0 +1,86 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func decimalTable(rows ...[]string) [][]decimal.Decimal {
+	table := make([][]decimal.Decimal, len(rows))
+	for i, row := range rows {
+		for _, s := range row {
+			table[i] = append(table[i], decimal.RequireFromString(s))
+		}
+	}
+	return table
+}
+
+func TestReportTotals(t *testing.T) {
+	tests := []struct {
+		name   string
+		cells  [][]decimal.Decimal
+		places int32
+		mode   RoundingMode
+		total  string
+	}{
+		{"thirds", decimalTable([]string{"0.333", "0.333", "0.334"}), 2, RoundHalfUp, "1"},
+		{"pivot", decimalTable(
+			[]string{"10.125", "3.335", "0.004"},
+			[]string{"2.495", "7.777", "1.115"},
+			[]string{"0.005", "0.005", "0.005"},
+		), 2, RoundHalfUp, "24.87"},
+		{"negative", decimalTable([]string{"-1.25", "2.35"}, []string{"0.45", "-0.15"}), 1, RoundHalfEven, "1.4"},
+		{"floor", decimalTable([]string{"0.19", "0.19"}, []string{"0.19", "0.19"}), 1, RoundFloor, "0.7"},
+		{"on grid", decimalTable([]string{"1.5", "2"}, []string{"3", "4.5"}), 1, RoundHalfUp, "11"},
+		{"empty", nil, 2, RoundHalfUp, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ReportTotals(tt.cells, tt.places, tt.mode)
+			if err != nil {
+				t.Fatalf("ReportTotals() error = %v", err)
+			}
+			if !r.Total.Equal(decimal.RequireFromString(tt.total)) {
+				t.Errorf("Total = %v, want %v", r.Total, tt.total)
+			}
+			unit := pow10Decimal(-tt.places)
+			grand := decimal.Zero
+			for i, row := range r.Cells {
+				rowSum := decimal.Zero
+				for j, c := range row {
+					if !c.Equal(c.Round(tt.places)) || c.Sub(tt.cells[i][j]).Abs().GreaterThanOrEqual(unit) {
+						t.Errorf("Cells[%d][%d] = %v, not an adjacent rounding of %v", i, j, c, tt.cells[i][j])
+					}
+					rowSum = rowSum.Add(c)
+				}
+				if !rowSum.Equal(r.RowTotals[i]) {
+					t.Errorf("RowTotals[%d] = %v, cells sum to %v", i, r.RowTotals[i], rowSum)
+				}
+				grand = grand.Add(rowSum)
+			}
+			for j, total := range r.ColTotals {
+				colSum := decimal.Zero
+				for i := range r.Cells {
+					colSum = colSum.Add(r.Cells[i][j])
+				}
+				if !colSum.Equal(total) {
+					t.Errorf("ColTotals[%d] = %v, cells sum to %v", j, total, colSum)
+				}
+			}
+			if !grand.Equal(r.Total) {
+				t.Errorf("Total = %v, cells sum to %v", r.Total, grand)
+			}
+		})
+	}
+
+	r, _ := ReportTotals(decimalTable([]string{"0.333", "0.333", "0.334"}), 2, RoundHalfUp)
+	if got := r.Cells[0][2].String(); got != "0.34" {
+		t.Errorf("largest remainder rounded to %v, want 0.34", got)
+	}
+	if _, err := ReportTotals(decimalTable([]string{"1", "2"}, []string{"3"}), 2, RoundHalfUp); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("ReportTotals() ragged error = %v, want ErrDimensionMismatch", err)
+	}
+}
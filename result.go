@@ -1,13 +1,19 @@
 package mathx
 
 import (
+	"fmt"
 	"math"
-	"strings"
+	"math/big"
+	"strconv"
 
 	"github.com/shopspring/decimal"
 )
 
-// Result represents a calculation result with chainable methods
+// Result represents a calculation result with chainable methods.
+//
+// Results that hold the same value may differ in representation (1.5 and 1.50),
+// so compare them with Equal or Cmp rather than ==, and use Key when a Result
+// has to serve as a map key or set member.
 type Result struct {
 	v decimal.Decimal
 }
@@ -17,6 +23,11 @@ func NewResult(value float64) Result {
 	return Result{v: decimal.NewFromFloat(value)}
 }
 
+// NewResultFromDecimal creates a new Result from a decimal value
+func NewResultFromDecimal(value decimal.Decimal) Result {
+	return Result{v: value}
+}
+
 // NewResultFromString creates a new Result from a string
 // This is useful for preserving precision when working with very large or very small numbers
 func NewResultFromString(value string) (Result, error) {
@@ -27,6 +38,16 @@ func NewResultFromString(value string) (Result, error) {
 	return Result{v: d}, nil
 }
 
+// MustResultFromString is like NewResultFromString but panics if the string cannot be parsed.
+// It simplifies safe initialization of package-level values.
+func MustResultFromString(value string) Result {
+	r, err := NewResultFromString(value)
+	if err != nil {
+		panic(`mathx: MustResultFromString(` + strconv.Quote(value) + `): ` + err.Error())
+	}
+	return r
+}
+
 func (r Result) Decimal() decimal.Decimal {
 	return r.v
 }
@@ -42,6 +63,132 @@ func (r Result) String() string {
 	return r.v.String()
 }
 
+// Format implements fmt.Formatter so that Results can be printed directly:
+// %v and %s print the exact value, %f does too unless a precision is given, and
+// %.2f rounds half away from zero like ToStringFixed. Width and the '+', ' ', '-'
+// and '0' flags work as for floats, and %q quotes the exact value.
+func (r Result) Format(f fmt.State, verb rune) {
+	var buf [64]byte
+	num := buf[:0]
+	prec, hasPrec := f.Precision()
+	switch verb {
+	case 'v', 's', 'f', 'F':
+		if hasPrec {
+			num = r.AppendFixed(num, int32(prec))
+		} else {
+			num = r.AppendString(num)
+		}
+	case 'q':
+		num = strconv.AppendQuote(num, r.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(mathx.Result=%s)", verb, r.String())
+		return
+	}
+
+	if verb != 'q' && num[0] != '-' {
+		switch {
+		case f.Flag('+'):
+			num = append([]byte{'+'}, num...)
+		case f.Flag(' '):
+			num = append([]byte{' '}, num...)
+		}
+	}
+	width, _ := f.Width()
+	switch {
+	case f.Flag('-'):
+		f.Write([]byte(Pad(string(num), width, ' ', AlignLeft)))
+	case f.Flag('0') && verb != 'q' && (num[0] == '-' || num[0] == '+' || num[0] == ' '):
+		f.Write(append(num[:1:1], Pad(string(num[1:]), width-1, '0', AlignRight)...))
+	case f.Flag('0') && verb != 'q':
+		f.Write([]byte(Pad(string(num), width, '0', AlignRight)))
+	default:
+		f.Write([]byte(Pad(string(num), width, ' ', AlignRight)))
+	}
+}
+
+// IsZero reports whether the result is exactly zero
+func (r Result) IsZero() bool {
+	return r.v.IsZero()
+}
+
+// IsPositive reports whether the result is greater than zero
+func (r Result) IsPositive() bool {
+	return r.v.IsPositive()
+}
+
+// IsNegative reports whether the result is less than zero
+func (r Result) IsNegative() bool {
+	return r.v.IsNegative()
+}
+
+// Sign returns the sign of the result (-1, 0, or 1)
+func (r Result) Sign() int {
+	return r.v.Sign()
+}
+
+// CopySign returns a Result with the magnitude of r and the sign of sign. Decimals have
+// no negative zero, so a zero sign yields |r| and a zero r stays zero.
+func (r Result) CopySign(sign Result) Result {
+	if sign.v.Sign() < 0 {
+		return Result{v: r.v.Abs().Neg()}
+	}
+	return Result{v: r.v.Abs()}
+}
+
+// IsInteger reports whether the result has no fractional part
+func (r Result) IsInteger() bool {
+	return r.v.IsInteger()
+}
+
+// Equal reports whether r and other hold the same value, regardless of representation
+func (r Result) Equal(other Result) bool {
+	return r.v.Equal(other.v)
+}
+
+// EqualValue is an alias for Equal: 1.10 and 1.1 hold the same value and are equal.
+// Use Identical to also require the same representation.
+func (r Result) EqualValue(other Result) bool {
+	return r.Equal(other)
+}
+
+// Identical reports whether r and other have the same representation, i.e. the same
+// coefficient and exponent: 1.1 and 1.1 are identical, 1.10 and 1.1 are not
+func (r Result) Identical(other Result) bool {
+	return r.v.Exponent() == other.v.Exponent() && r.v.Equal(other.v)
+}
+
+// Normalize returns r with trailing fractional zeros removed from its representation,
+// so 1.500 becomes 1.5 and 2.00 becomes 2. Integers get exponent zero. The value is
+// unchanged, and two Results hold equal values exactly when their normalized forms
+// are Identical.
+func (r Result) Normalize() Result {
+	return Result{v: normalizeDecimal(r.v)}
+}
+
+// Cmp compares r and other and returns -1, 0 or +1
+func (r Result) Cmp(other Result) int {
+	return r.v.Cmp(other.v)
+}
+
+// Key returns a canonical string for the value of r, so that two Results have
+// the same Key exactly when they are Equal. It is meant for map keys and sets.
+func (r Result) Key() string {
+	// String drops trailing fractional zeros and prints integers without exponent,
+	// so every value has exactly one spelling
+	return r.v.String()
+}
+
+// Exponent returns the exponent of the underlying decimal, so that the value is
+// coefficient * 10^Exponent. A value parsed from "1.50" has exponent -2.
+func (r Result) Exponent() int32 {
+	return r.v.Exponent()
+}
+
+// NumDigits returns the number of digits of the coefficient of the underlying decimal
+func (r Result) NumDigits() int {
+	return r.v.NumDigits()
+}
+
 // ToString returns the string representation
 func (r Result) ToString() string {
 	return r.v.String()
@@ -52,21 +199,99 @@ func (r Result) ToStringFixed(places int32) string {
 	return r.v.StringFixed(places)
 }
 
+// AppendString appends the string representation of the result to dst
+func (r Result) AppendString(dst []byte) []byte {
+	return appendStringDecimal(dst, r.v)
+}
+
+// AppendFixed appends the result with fixed decimal places to dst
+func (r Result) AppendFixed(dst []byte, places int32) []byte {
+	return appendFixedDecimal(dst, r.v, places)
+}
+
 // ToStringBank returns the string with banker's rounding
 func (r Result) ToStringBank(places int32) string {
 	return r.v.StringFixedBank(places)
 }
 
-// Clean removes trailing zeros and returns a new Result
+// Clean removes trailing zeros and returns a new Result. It is equivalent to Normalize.
 func (r Result) Clean() Result {
-	// 转换为字符串去除尾随零，再转回decimal
-	str := r.v.String()
-	if strings.Contains(str, ".") {
-		str = strings.TrimRight(str, "0")
-		str = strings.TrimRight(str, ".")
+	return r.Normalize()
+}
+
+// normalizeDecimal strips trailing zeros from the coefficient of d while its exponent is
+// negative, and rescales integers with a positive exponent to exponent zero
+func normalizeDecimal(d decimal.Decimal) decimal.Decimal {
+	exp := d.Exponent()
+	switch {
+	case d.IsZero():
+		return decimal.New(0, 0)
+	case exp > 0:
+		return d.Round(0)
+	case exp == 0:
+		return d
+	}
+	if d.NumDigits() <= 18 {
+		c := d.CoefficientInt64()
+		for exp < 0 && c%10 == 0 {
+			c /= 10
+			exp++
+		}
+		return decimal.New(c, exp)
+	}
+	c := d.Coefficient()
+	q, rem := new(big.Int), new(big.Int)
+	ten := big.NewInt(10)
+	for exp < 0 {
+		q.QuoRem(c, ten, rem)
+		if rem.Sign() != 0 {
+			break
+		}
+		c, q = q, c
+		exp++
+	}
+	return decimal.NewFromBigInt(c, exp)
+}
+
+// IntPart returns the integer part of the result, truncated toward zero
+func (r Result) IntPart() int64 {
+	return r.v.IntPart()
+}
+
+// Int64Checked returns the result as an int64. It returns ErrNotInteger if the result
+// has a fractional part and ErrOverflow if it does not fit in an int64.
+func (r Result) Int64Checked() (int64, error) {
+	return checkedInt(r.v, math.MinInt64, math.MaxInt64)
+}
+
+// IntChecked returns the result as an int. It returns ErrNotInteger if the result
+// has a fractional part and ErrOverflow if it does not fit in an int.
+func (r Result) IntChecked() (int, error) {
+	i, err := checkedInt(r.v, math.MinInt, math.MaxInt)
+	return int(i), err
+}
+
+// checkedInt converts an integral decimal within [lo, hi] to an int64
+func checkedInt(d decimal.Decimal, lo, hi int64) (int64, error) {
+	if !d.IsInteger() {
+		return 0, ErrNotInteger
+	}
+	if d.LessThan(decimal.NewFromInt(lo)) || d.GreaterThan(decimal.NewFromInt(hi)) {
+		return 0, ErrOverflow
 	}
-	cleanValue, _ := decimal.NewFromString(str)
-	return Result{v: cleanValue}
+	return d.IntPart(), nil
+}
+
+// FracPart returns the fractional part of the result, which has the same sign as
+// the result, e.g. -1.25 yields -0.25
+func (r Result) FracPart() Result {
+	return Result{v: r.v.Sub(r.v.Truncate(0))}
+}
+
+// Shift multiplies the result by 10^n, e.g. Shift(2) converts dollars to cents
+// and Shift(-2) converts cents to dollars
+func (r Result) Shift(n int32) Result {
+	return Result{v: r.v.Shift(n)}
 }
 
 // Round rounds to specified precision and returns a new Result
@@ -79,39 +304,42 @@ func (r Result) Truncate(places int32) Result {
 	if places < 0 {
 		// For negative precision, truncate to integer places
 		// e.g., precision -1 means truncate to tens place
-		multiplier := decimal.NewFromFloat(math.Pow(10, float64(-places)))
+		multiplier := pow10Decimal(-places)
 		result := r.v.Div(multiplier).Truncate(0).Mul(multiplier)
 		return Result{v: result}
 	}
 	return Result{v: r.v.Truncate(places)}
 }
 
+// Floor returns the largest integer less than or equal to the result
+func (r Result) Floor() Result {
+	return Result{v: r.v.Floor()}
+}
+
+// Ceil returns the smallest integer greater than or equal to the result
+func (r Result) Ceil() Result {
+	return Result{v: r.v.Ceil()}
+}
+
+// RoundUp rounds away from zero to specified precision and returns a new Result
+func (r Result) RoundUp(places int32) Result {
+	return Result{v: r.v.RoundUp(places)}
+}
+
+// RoundDown rounds toward zero to specified precision and returns a new Result
+func (r Result) RoundDown(places int32) Result {
+	return Result{v: r.v.RoundDown(places)}
+}
+
 // FormatMoney formats as currency with thousands separator
 func (r Result) FormatMoney(decimalPlaces int32) string {
-	rounded := r.v.Round(decimalPlaces)
-	str := rounded.StringFixed(decimalPlaces)
-
-	// 分离整数和小数部分
-	parts := strings.Split(str, ".")
-	integerPart := parts[0]
-	decimalPart := ""
-	if len(parts) > 1 {
-		decimalPart = "." + parts[1]
-	}
-
-	// 添加千位分隔符
-	if len(integerPart) > 3 {
-		var result strings.Builder
-		for i, char := range integerPart {
-			if i > 0 && (len(integerPart)-i)%3 == 0 {
-				result.WriteString(",")
-			}
-			result.WriteRune(char)
-		}
-		integerPart = result.String()
-	}
+	var buf [64]byte
+	return string(r.AppendMoney(buf[:0], decimalPlaces))
+}
 
-	return integerPart + decimalPart
+// AppendMoney appends the result formatted as currency with thousands separator to dst
+func (r Result) AppendMoney(dst []byte, decimalPlaces int32) []byte {
+	return appendMoneyDecimal(dst, r.v, decimalPlaces, GroupComma)
 }
 
 // Abs returns the absolute value
@@ -124,6 +352,21 @@ func (r Result) Neg() Result {
 	return Result{v: r.v.Neg()}
 }
 
+// Min returns the smaller of this result and other
+func (r Result) Min(other decimal.Decimal) Result {
+	return Result{v: MinSafe(r.v, other)}
+}
+
+// Max returns the larger of this result and other
+func (r Result) Max(other decimal.Decimal) Result {
+	return Result{v: MaxSafe(r.v, other)}
+}
+
+// Clamp clamps this result between lo and hi
+func (r Result) Clamp(lo, hi decimal.Decimal) Result {
+	return Result{v: ClampSafe(r.v, lo, hi)}
+}
+
 // Add adds another decimal to this result
 func (r Result) Add(other decimal.Decimal) Result {
 	return Result{v: r.v.Add(other)}
@@ -148,3 +391,19 @@ func (r Result) Div(other decimal.Decimal, precision int32) Result {
 func (r Result) DivTrunc(other decimal.Decimal, precision int32) Result {
 	return Result{v: r.v.Div(other).Truncate(precision)}
 }
+
+// Apply applies a custom transform to the result and returns a new Result,
+// e.g. a tax rule or cap that is not covered by the built-in methods
+func (r Result) Apply(fn func(decimal.Decimal) decimal.Decimal) Result {
+	return Result{v: fn(r.v)}
+}
+
+// ApplyE applies a custom transform that may fail to the result.
+// On error it returns the original result together with the error.
+func (r Result) ApplyE(fn func(decimal.Decimal) (decimal.Decimal, error)) (Result, error) {
+	v, err := fn(r.v)
+	if err != nil {
+		return r, err
+	}
+	return Result{v: v}, nil
+}
@@ -0,0 +1,143 @@
+package mathx
+
+import (
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode selects how a value between two representable results is rounded
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest result, ties away from zero (as Round does)
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest result, ties to the even one (banker's rounding)
+	RoundHalfEven
+	// RoundHalfDown rounds to the nearest result, ties towards zero
+	RoundHalfDown
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundDown rounds towards zero (truncation)
+	RoundDown
+	// RoundCeiling rounds towards positive infinity
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity
+	RoundFloor
+)
+
+// String returns the name of the rounding mode, e.g. "HalfEven"
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfUp:
+		return "HalfUp"
+	case RoundHalfEven:
+		return "HalfEven"
+	case RoundHalfDown:
+		return "HalfDown"
+	case RoundUp:
+		return "Up"
+	case RoundDown:
+		return "Down"
+	case RoundCeiling:
+		return "Ceiling"
+	case RoundFloor:
+		return "Floor"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// RoundWith rounds value to precision decimal places using mode
+func RoundWith(value float64, precision int32, mode RoundingMode) Result {
+	return Result{v: roundDecimal(decimal.NewFromFloat(value), precision, mode)}
+}
+
+// RoundWith rounds the result to places decimal places using mode
+func (r Result) RoundWith(places int32, mode RoundingMode) Result {
+	return Result{v: roundDecimal(r.v, places, mode)}
+}
+
+// RoundToMultiple rounds value to a multiple of step using mode,
+// e.g. RoundToMultiple(1.37, 0.25, RoundHalfUp) returns 1.25
+func RoundToMultiple(value, step float64, mode RoundingMode) Result {
+	return Quantize(value, step, 0, mode)
+}
+
+// Quantize snaps value to the grid offset + k*step using mode, e.g. with step 0.25
+// and offset 0.10 the grid is ..., -0.15, 0.10, 0.35, 0.60, ... The computation is
+// exact in decimal. A step that is not positive returns value unchanged.
+func Quantize(value, step, offset float64, mode RoundingMode) Result {
+	v := decimal.NewFromFloat(value)
+	if !(step > 0) {
+		return Result{v: v}
+	}
+	return Result{v: quantizeDecimal(v, decimal.NewFromFloat(step), decimal.NewFromFloat(offset), mode)}
+}
+
+// roundDecimal rounds d to places decimal places using mode
+func roundDecimal(d decimal.Decimal, places int32, mode RoundingMode) decimal.Decimal {
+	return quantizeDecimal(d, pow10Decimal(-places), decimal.Zero, mode)
+}
+
+// quantizeDecimal rounds d to offset + k*step for a positive step. It splits d - offset
+// into an integer quotient and exact remainder, so ties are detected exactly.
+func quantizeDecimal(d, step, offset decimal.Decimal, mode RoundingMode) decimal.Decimal {
+	x := d.Sub(offset)
+	q, rem := x.QuoRem(step, 0)
+	if rem.IsZero() {
+		return d
+	}
+	// the remainder has the sign of x; away is the direction away from zero
+	away := decimal.NewFromInt(int64(x.Sign()))
+	half := rem.Abs().Add(rem.Abs()).Cmp(step)
+	var bump bool
+	switch mode {
+	case RoundHalfUp:
+		bump = half >= 0
+	case RoundHalfEven:
+		bump = half > 0 || (half == 0 && !q.Mod(decimalTwo).IsZero())
+	case RoundHalfDown:
+		bump = half > 0
+	case RoundUp:
+		bump = true
+	case RoundCeiling:
+		bump = x.Sign() > 0
+	case RoundFloor:
+		bump = x.Sign() < 0
+	}
+	if bump {
+		q = q.Add(away)
+	}
+	return q.Mul(step).Add(offset)
+}
+
+// divRoundWith divides a by b and rounds the quotient to places decimal places using
+// mode. The remainder is kept exact, so ties are detected exactly. It panics if b is zero.
+func divRoundWith(a, b decimal.Decimal, places int32, mode RoundingMode) decimal.Decimal {
+	q, rem := a.QuoRem(b, places)
+	if rem.IsZero() {
+		return q
+	}
+	// the true quotient is q + rem/b; compare |rem/b| with half a unit in the last place
+	sign := a.Sign() * b.Sign()
+	half := rem.Abs().Add(rem.Abs()).Cmp(b.Abs().Mul(pow10Decimal(-places)))
+	var bump bool
+	switch mode {
+	case RoundHalfUp:
+		bump = half >= 0
+	case RoundHalfEven:
+		bump = half > 0 || (half == 0 && !q.Shift(places).Mod(decimalTwo).IsZero())
+	case RoundHalfDown:
+		bump = half > 0
+	case RoundUp:
+		bump = true
+	case RoundCeiling:
+		bump = sign > 0
+	case RoundFloor:
+		bump = sign < 0
+	}
+	if bump {
+		q = q.Add(decimal.New(int64(sign), -places))
+	}
+	return q
+}
@@ -0,0 +1,79 @@
+package mathx
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRoundWith(t *testing.T) {
+	values := []float64{2.5, 3.5, -2.5, 2.51, -2.49, 2}
+	tests := []struct {
+		mode     RoundingMode
+		expected []string
+	}{
+		{RoundHalfUp, []string{"3", "4", "-3", "3", "-2", "2"}},
+		{RoundHalfEven, []string{"2", "4", "-2", "3", "-2", "2"}},
+		{RoundHalfDown, []string{"2", "3", "-2", "3", "-2", "2"}},
+		{RoundUp, []string{"3", "4", "-3", "3", "-3", "2"}},
+		{RoundDown, []string{"2", "3", "-2", "2", "-2", "2"}},
+		{RoundCeiling, []string{"3", "4", "-2", "3", "-2", "2"}},
+		{RoundFloor, []string{"2", "3", "-3", "2", "-3", "2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			for i, v := range values {
+				if got := RoundWith(v, 0, tt.mode).String(); got != tt.expected[i] {
+					t.Errorf("RoundWith(%v, 0, %v) = %v, want %v", v, tt.mode, got, tt.expected[i])
+				}
+			}
+		})
+	}
+
+	if got := NewResult(1.005).RoundWith(2, RoundHalfEven).String(); got != "1" {
+		t.Errorf("Result.RoundWith() = %v, want 1", got)
+	}
+	if got := NewResult(1.015).RoundWith(2, RoundHalfEven).String(); got != "1.02" {
+		t.Errorf("Result.RoundWith() = %v, want 1.02", got)
+	}
+}
+
+func TestQuantize(t *testing.T) {
+	tests := []struct {
+		value, step, offset float64
+		mode                RoundingMode
+		expected            string
+	}{
+		{1.37, 0.25, 0, RoundHalfUp, "1.25"},
+		{1.375, 0.25, 0, RoundHalfUp, "1.5"},
+		{1.375, 0.25, 0, RoundHalfEven, "1.5"},
+		{1.125, 0.25, 0, RoundHalfEven, "1"},
+		{0.5, 0.25, 0.1, RoundHalfUp, "0.6"},
+		{0.2, 0.25, 0.1, RoundFloor, "0.1"},
+		{0.2, 0.25, 0.1, RoundCeiling, "0.35"},
+		{-0.2, 0.25, 0.1, RoundHalfUp, "-0.15"},
+		{101.03, 0.05, 0, RoundDown, "101"},
+		{7, 3, 0, RoundUp, "9"},
+		{-7, 3, 0, RoundUp, "-9"},
+		{1.2, 0, 0, RoundHalfUp, "1.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v/%v/%v/%v", tt.value, tt.step, tt.offset, tt.mode), func(t *testing.T) {
+			if got := Quantize(tt.value, tt.step, tt.offset, tt.mode).String(); got != tt.expected {
+				t.Errorf("Quantize() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	a := 0.1
+	if got := RoundToMultiple(a+0.2, 0.1, RoundHalfUp).String(); got != "0.3" {
+		t.Errorf("RoundToMultiple() = %v, want 0.3", got)
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	if got := RoundingMode(99).String(); got != "RoundingMode(99)" {
+		t.Errorf("String() = %v", got)
+	}
+}
@@ -0,0 +1,36 @@
+package mathx
+
+import (
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+// Less reports whether a is less than b by value
+func Less(a, b Result) bool {
+	return a.v.LessThan(b.v)
+}
+
+// SortResults sorts rs in increasing order of value
+func SortResults(rs []Result) {
+	slices.SortFunc(rs, Result.Cmp)
+}
+
+// SortDecimals sorts ds in increasing order of value
+func SortDecimals(ds []decimal.Decimal) {
+	slices.SortFunc(ds, decimal.Decimal.Cmp)
+}
+
+// ResultSlice attaches the methods of sort.Interface to []Result, sorting by value
+type ResultSlice []Result
+
+func (s ResultSlice) Len() int           { return len(s) }
+func (s ResultSlice) Less(i, j int) bool { return s[i].v.LessThan(s[j].v) }
+func (s ResultSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// DecimalSlice attaches the methods of sort.Interface to []decimal.Decimal, sorting by value
+type DecimalSlice []decimal.Decimal
+
+func (s DecimalSlice) Len() int           { return len(s) }
+func (s DecimalSlice) Less(i, j int) bool { return s[i].LessThan(s[j]) }
+func (s DecimalSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,57 @@
+package mathx
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+// keys returns the canonical strings of rs
+func keys(rs []Result) []string {
+	out := make([]string, len(rs))
+	for i, r := range rs {
+		out[i] = r.Key()
+	}
+	return out
+}
+
+func TestSortResults(t *testing.T) {
+	rs := []Result{
+		MustResultFromString("10"),
+		MustResultFromString("9.99999999999999999999"),
+		MustResultFromString("-1"),
+		MustResultFromString("9.999999999999999999999"),
+	}
+	want := "[-1 9.99999999999999999999 9.999999999999999999999 10]"
+
+	SortResults(rs)
+	if got := keys(rs); fmt.Sprint(got) != want {
+		t.Errorf("SortResults() = %v, want %v", got, want)
+	}
+
+	rs[0], rs[3] = rs[3], rs[0]
+	sort.Sort(ResultSlice(rs))
+	if got := keys(rs); fmt.Sprint(got) != want {
+		t.Errorf("sort.Sort(ResultSlice) = %v, want %v", got, want)
+	}
+
+	if !Less(rs[0], rs[1]) || Less(rs[1], rs[0]) || Less(rs[0], rs[0]) {
+		t.Error("Less() gives an inconsistent order")
+	}
+}
+
+func TestSortDecimals(t *testing.T) {
+	ds := decimals("3", "0.3", "-3", "0.30000000000000000000001")
+	want := "[-3 0.3 0.30000000000000000000001 3]"
+
+	SortDecimals(ds)
+	if got := fmt.Sprint(ds); got != want {
+		t.Errorf("SortDecimals() = %v, want %v", got, want)
+	}
+
+	ds[0], ds[3] = ds[3], ds[0]
+	sort.Sort(DecimalSlice(ds))
+	if got := fmt.Sprint(ds); got != want {
+		t.Errorf("sort.Sort(DecimalSlice) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+package mathx
+
+import "github.com/shopspring/decimal"
+
+// billPlaces is the number of decimal places (cents) bill shares are rounded to
+const billPlaces = 2
+
+// SplitBill splits total into n shares of whole cents that add up exactly to total
+// rounded to cents. Each share starts as total/n rounded with mode; the cents left
+// over go one each to the first shares, and cents overcharged are taken one each
+// from the last shares, so the outcome is deterministic. It returns nil if n <= 0.
+func SplitBill(total float64, n int, mode RoundingMode) []Result {
+	return SplitBillSafe(decimal.NewFromFloat(total), n, mode)
+}
+
+// SplitBillSafe is the decimal counterpart of SplitBill, for totals that must not pass
+// through float64
+func SplitBillSafe(total decimal.Decimal, n int, mode RoundingMode) []Result {
+	if n <= 0 {
+		return nil
+	}
+	t := total.Round(billPlaces)
+	count := decimal.NewFromInt(int64(n))
+	base := roundDecimal(t.DivRound(count, divisionPlaces), billPlaces, mode)
+	cent := pow10Decimal(-billPlaces)
+	leftover := t.Sub(base.Mul(count)).Div(cent).IntPart()
+
+	shares := make([]Result, n)
+	for i := range shares {
+		share := base
+		switch {
+		case leftover > 0 && int64(i) < leftover:
+			share = share.Add(cent)
+		case leftover < 0 && int64(n-i) <= -leftover:
+			share = share.Sub(cent)
+		}
+		shares[i] = Result{v: share}
+	}
+	return shares
+}
+
+// SplitBillWeighted splits total into shares of whole cents proportional to weights,
+// e.g. by what each person ordered. The shares add up exactly to total rounded to
+// cents; leftover cents are assigned with the largest-remainder method of Apportion.
+func SplitBillWeighted(total float64, weights []float64) []Result {
+	cents := decimal.NewFromFloat(total).Round(billPlaces).Shift(billPlaces).IntPart()
+	parts := Apportion(cents, weights)
+	if parts == nil {
+		return nil
+	}
+	shares := make([]Result, len(parts))
+	for i, p := range parts {
+		shares[i] = Result{v: decimal.New(p, -billPlaces)}
+	}
+	return shares
+}
@@ -0,0 +1,96 @@
+package mathx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// sumResults adds up rs exactly
+func sumResults(rs []Result) decimal.Decimal {
+	sum := decimal.Zero
+	for _, r := range rs {
+		sum = sum.Add(r.Decimal())
+	}
+	return sum
+}
+
+func TestSplitBill(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    float64
+		n        int
+		mode     RoundingMode
+		expected string
+	}{
+		{"even", 90, 3, RoundHalfUp, "[30 30 30]"},
+		{"extra cent to first", 100, 3, RoundHalfUp, "[33.34 33.33 33.33]"},
+		{"round up takes from last", 100, 3, RoundUp, "[33.34 33.33 33.33]"},
+		{"two extra cents", 0.05, 3, RoundDown, "[0.02 0.02 0.01]"},
+		{"overcharge removed from last", 0.05, 3, RoundUp, "[0.02 0.02 0.01]"},
+		{"half even", 10.01, 2, RoundHalfEven, "[5.01 5]"},
+		{"refund", -100, 3, RoundHalfUp, "[-33.33 -33.33 -33.34]"},
+		{"single", 12.345, 1, RoundHalfUp, "[12.35]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitBill(tt.total, tt.n, tt.mode)
+			if s := fmt.Sprint(got); s != tt.expected {
+				t.Errorf("SplitBill() = %v, want %v", s, tt.expected)
+			}
+			want := decimal.NewFromFloat(tt.total).Round(2)
+			if sum := sumResults(got); !sum.Equal(want) {
+				t.Errorf("SplitBill() sums to %v, want %v", sum, want)
+			}
+		})
+	}
+
+	if got := SplitBill(10, 0, RoundHalfUp); got != nil {
+		t.Errorf("SplitBill() with n = 0 = %v, want nil", got)
+	}
+}
+
+func TestSplitBillSafe(t *testing.T) {
+	total := decimal.RequireFromString("90071992547409.97")
+	shares := SplitBillSafe(total, 3, RoundHalfUp)
+	if sum := sumResults(shares); !sum.Equal(total) {
+		t.Errorf("SplitBillSafe() shares add up to %v, want %v", sum, total)
+	}
+	if got := fmt.Sprint(shares); got != "[30023997515803.33 30023997515803.32 30023997515803.32]" {
+		t.Errorf("SplitBillSafe() = %v", got)
+	}
+	if got := fmt.Sprint(SplitBillSafe(decimal.RequireFromString("10"), 3, RoundHalfUp)); got != fmt.Sprint(SplitBill(10, 3, RoundHalfUp)) {
+		t.Errorf("SplitBillSafe(10, 3) = %v, want SplitBill(10, 3)", got)
+	}
+}
+
+func TestSplitBillWeighted(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    float64
+		weights  []float64
+		expected string
+	}{
+		{"proportional", 100, []float64{1, 3}, "[25 75]"},
+		{"leftover cent", 100, []float64{1, 1, 1}, "[33.34 33.33 33.33]"},
+		{"by order", 57.3, []float64{12.5, 20, 8.9}, "[17.3 27.68 12.32]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitBillWeighted(tt.total, tt.weights)
+			if s := fmt.Sprint(got); s != tt.expected {
+				t.Errorf("SplitBillWeighted() = %v, want %v", s, tt.expected)
+			}
+			if sum := sumResults(got); !sum.Equal(decimal.NewFromFloat(tt.total)) {
+				t.Errorf("SplitBillWeighted() sums to %v, want %v", sum, tt.total)
+			}
+		})
+	}
+
+	if got := SplitBillWeighted(10, nil); got != nil {
+		t.Errorf("SplitBillWeighted() with no weights = %v, want nil", got)
+	}
+}
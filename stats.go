@@ -0,0 +1,413 @@
+package mathx
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/shopspring/decimal"
+	"golang.org/x/exp/constraints"
+)
+
+// statsPrecision is the number of decimal places kept by the decimal statistics functions
+const statsPrecision = 32
+
+// divisionPlaces is the number of decimal places kept by intermediate divisions in
+// the money, rate and date functions before their results are rounded
+const divisionPlaces = 32
+
+// MedianSafe returns the median of decimal values. For an even number of values
+// it returns the mean of the two middle values.
+func MedianSafe(ds ...decimal.Decimal) decimal.Decimal {
+	if len(ds) == 0 {
+		return decimal.Zero
+	}
+	return percentileSorted(sortedDecimals(ds), 50)
+}
+
+// PercentileSafe returns the p-th percentile (0-100) of decimal values using linear
+// interpolation between closest ranks. p is clamped to [0, 100]. It returns
+// ErrNotFinite if p is NaN or ±Inf.
+func PercentileSafe(p float64, ds ...decimal.Decimal) (decimal.Decimal, error) {
+	if math.IsNaN(p) || math.IsInf(p, 0) {
+		return decimal.Decimal{}, ErrNotFinite
+	}
+	if len(ds) == 0 {
+		return decimal.Zero, nil
+	}
+	return percentileSorted(sortedDecimals(ds), p), nil
+}
+
+// VarianceSafe returns the sample variance (n-1) of decimal values
+func VarianceSafe(ds ...decimal.Decimal) decimal.Decimal {
+	if len(ds) < 2 {
+		return decimal.Zero
+	}
+	avg := AverageSafe(ds...)
+	sum := decimal.Zero
+	for _, d := range ds {
+		diff := d.Sub(avg)
+		sum = sum.Add(diff.Mul(diff))
+	}
+	return sum.DivRound(decimal.NewFromInt(int64(len(ds)-1)), statsPrecision)
+}
+
+// StandardDeviationSafe returns the sample standard deviation (n-1) of decimal values
+func StandardDeviationSafe(ds ...decimal.Decimal) decimal.Decimal {
+	return sqrtDecimal(VarianceSafe(ds...), statsPrecision)
+}
+
+// sortedDecimals returns a sorted copy of ds
+func sortedDecimals(ds []decimal.Decimal) []decimal.Decimal {
+	sorted := slices.Clone(ds)
+	slices.SortFunc(sorted, decimal.Decimal.Cmp)
+	return sorted
+}
+
+// percentileSorted interpolates the p-th percentile of non-empty sorted values
+func percentileSorted(sorted []decimal.Decimal, p float64) decimal.Decimal {
+	p = Clamp(p, 0, 100)
+	rank := decimal.NewFromFloat(p).Mul(decimal.NewFromInt(int64(len(sorted) - 1))).Div(decimalHundred)
+	lo := rank.IntPart()
+	frac := rank.Sub(decimal.NewFromInt(lo))
+	if frac.IsZero() {
+		return sorted[lo]
+	}
+	return sorted[lo].Add(sorted[lo+1].Sub(sorted[lo]).Mul(frac))
+}
+
+// sqrtDecimal returns the square root of d rounded to places using Newton's method.
+// It returns zero for non-positive values.
+func sqrtDecimal(d decimal.Decimal, places int32) decimal.Decimal {
+	if d.Sign() <= 0 {
+		return decimal.Zero
+	}
+	// seed Newton from the float estimate, or from the decimal exponent when d lies
+	// outside the float64 range
+	var x decimal.Decimal
+	if f := math.Sqrt(d.InexactFloat64()); f > 0 && !math.IsInf(f, 0) {
+		x = decimal.NewFromFloat(f)
+	} else {
+		x = decimal.New(1, (d.Exponent()+int32(d.NumDigits()))/2)
+	}
+	tolerance := decimal.New(1, -(places + 2))
+	for i := 0; i < 100; i++ {
+		next := x.Add(d.DivRound(x, places+4)).Mul(decimalHalf)
+		if next.Sub(x).Abs().LessThan(tolerance) {
+			x = next
+			break
+		}
+		x = next
+	}
+	return x.Round(places)
+}
+
+// Median returns the median of a slice of numbers. For an even number of values
+// it returns the mean of the two middle values.
+func Median[T constraints.Integer | constraints.Float](ns ...T) float64 {
+	return Percentile(50, ns...)
+}
+
+// MedianE is like Median but returns ErrEmptyInput for an empty slice instead of 0
+func MedianE[T constraints.Integer | constraints.Float](ns ...T) (float64, error) {
+	if len(ns) == 0 {
+		return 0, ErrEmptyInput
+	}
+	return Median(ns...), nil
+}
+
+// Percentile returns the p-th percentile (0-100) of a slice of numbers using linear
+// interpolation between closest ranks. p is clamped to [0, 100]; a NaN p gives NaN.
+func Percentile[T constraints.Integer | constraints.Float](p float64, ns ...T) float64 {
+	if len(ns) == 0 {
+		return 0
+	}
+	return percentileSortedFloat(sortedFloats(ns), p)
+}
+
+// Quartiles returns the first, second (median) and third quartiles of a slice of
+// numbers, interpolated as in Percentile
+func Quartiles[T constraints.Integer | constraints.Float](ns ...T) (q1, q2, q3 float64) {
+	if len(ns) == 0 {
+		return 0, 0, 0
+	}
+	sorted := sortedFloats(ns)
+	return percentileSortedFloat(sorted, 25), percentileSortedFloat(sorted, 50), percentileSortedFloat(sorted, 75)
+}
+
+// IQR returns the interquartile range Q3 - Q1 of a slice of numbers
+func IQR[T constraints.Integer | constraints.Float](ns ...T) float64 {
+	q1, _, q3 := Quartiles(ns...)
+	return q3 - q1
+}
+
+// BoxPlotFences returns the fences Q1 - k*IQR and Q3 + k*IQR outside which values
+// count as outliers. Tukey's fences use k = 1.5 for outliers and k = 3 for far outliers.
+func BoxPlotFences[T constraints.Integer | constraints.Float](values []T, k float64) (lower, upper float64) {
+	q1, _, q3 := Quartiles(values...)
+	iqr := q3 - q1
+	return q1 - k*iqr, q3 + k*iqr
+}
+
+// MADConsistency scales the median absolute deviation of normally distributed data
+// to an estimate of the standard deviation
+const MADConsistency = 1.4826
+
+// MeanAbsoluteDeviation returns the mean absolute deviation of a slice of numbers
+// around their mean
+func MeanAbsoluteDeviation[T constraints.Integer | constraints.Float](ns ...T) float64 {
+	if len(ns) == 0 {
+		return 0
+	}
+	mean := Average(ns...)
+	var sum float64
+	for _, n := range ns {
+		sum += math.Abs(float64(n) - mean)
+	}
+	return sum / float64(len(ns))
+}
+
+// MedianAbsoluteDeviation returns the median of the absolute deviations of a slice of
+// numbers from their median. Unlike StandardDeviation it is barely affected by outliers.
+func MedianAbsoluteDeviation[T constraints.Integer | constraints.Float](ns ...T) float64 {
+	if len(ns) == 0 {
+		return 0
+	}
+	median := Median(ns...)
+	deviations := make([]float64, len(ns))
+	for i, n := range ns {
+		deviations[i] = math.Abs(float64(n) - median)
+	}
+	return Median(deviations...)
+}
+
+// NormalizedMAD returns MedianAbsoluteDeviation scaled by MADConsistency, a robust
+// estimate of the standard deviation for normally distributed data
+func NormalizedMAD[T constraints.Integer | constraints.Float](ns ...T) float64 {
+	return MADConsistency * MedianAbsoluteDeviation(ns...)
+}
+
+// ECDF returns the empirical cumulative distribution function of values: the
+// returned function reports the fraction of values <= x, e.g. the share of orders
+// at or below a price. NaN values are ignored; with no values it always returns 0.
+// values is copied, so later changes to it do not affect the function.
+func ECDF(values []float64) func(x float64) float64 {
+	sorted := sortedNonNaN(values)
+	return func(x float64) float64 {
+		if len(sorted) == 0 {
+			return 0
+		}
+		return float64(countAtMost(sorted, x)) / float64(len(sorted))
+	}
+}
+
+// SurvivalFunction returns the complement of ECDF: the returned function reports
+// the fraction of values > x. NaN values are ignored; with no values it always returns 0.
+func SurvivalFunction(values []float64) func(x float64) float64 {
+	sorted := sortedNonNaN(values)
+	return func(x float64) float64 {
+		if len(sorted) == 0 {
+			return 0
+		}
+		return float64(len(sorted)-countAtMost(sorted, x)) / float64(len(sorted))
+	}
+}
+
+// countAtMost returns how many of the sorted values are <= x
+func countAtMost(sorted []float64, x float64) int {
+	return sort.Search(len(sorted), func(i int) bool { return sorted[i] > x })
+}
+
+// sortedNonNaN returns a sorted copy of values without NaNs
+func sortedNonNaN(values []float64) []float64 {
+	sorted := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sorted = append(sorted, v)
+		}
+	}
+	slices.Sort(sorted)
+	return sorted
+}
+
+// The Nan functions treat NaN entries as missing values and skip them. Like their
+// counterparts Sum, Average, Max, Min and StandardDeviation they return 0 when no
+// values remain.
+
+// NanSum returns the sum of the non-NaN values
+func NanSum(values ...float64) float64 {
+	var sum float64
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sum += v
+		}
+	}
+	return sum
+}
+
+// NanMean returns the mean of the non-NaN values
+func NanMean(values ...float64) float64 {
+	var sum float64
+	n := 0
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sum += v
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / float64(n)
+}
+
+// NanMax returns the largest non-NaN value
+func NanMax(values ...float64) float64 {
+	return nanExtreme(values, func(a, b float64) bool { return a > b })
+}
+
+// NanMin returns the smallest non-NaN value
+func NanMin(values ...float64) float64 {
+	return nanExtreme(values, func(a, b float64) bool { return a < b })
+}
+
+// NanStd returns the sample standard deviation (n-1) of the non-NaN values, or 0 if
+// fewer than two remain
+func NanStd(values ...float64) float64 {
+	mean := NanMean(values...)
+	var sq float64
+	n := 0
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			diff := v - mean
+			sq += diff * diff
+			n++
+		}
+	}
+	if n < 2 {
+		return 0
+	}
+	return math.Sqrt(sq / float64(n-1))
+}
+
+// nanExtreme returns the non-NaN value v for which better(v, w) holds against every other w
+func nanExtreme(values []float64, better func(a, b float64) bool) float64 {
+	found := false
+	var best float64
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		if !found || better(v, best) {
+			best, found = v, true
+		}
+	}
+	return best
+}
+
+// Summary holds descriptive statistics of a set of float64 values
+type Summary struct {
+	Count int
+	Sum   float64
+	Mean  float64
+	Std   float64 // sample standard deviation (n-1)
+	Min   float64
+	Max   float64
+	P25   float64
+	P50   float64
+	P75   float64
+}
+
+// Describe computes count, sum, mean, standard deviation, extremes and quartiles of
+// values in two passes plus one sort. It uses float64 arithmetic throughout; use
+// DescribeSafe when decimal precision matters.
+func Describe(values ...float64) Summary {
+	if len(values) == 0 {
+		return Summary{}
+	}
+	s := Summary{Count: len(values), Min: values[0], Max: values[0]}
+	for _, v := range values {
+		s.Sum += v
+		s.Min = min(s.Min, v)
+		s.Max = max(s.Max, v)
+	}
+	s.Mean = s.Sum / float64(s.Count)
+	if s.Count > 1 {
+		var sq float64
+		for _, v := range values {
+			diff := v - s.Mean
+			sq += diff * diff
+		}
+		s.Std = math.Sqrt(sq / float64(s.Count-1))
+	}
+	sorted := sortedFloats(values)
+	s.P25 = percentileSortedFloat(sorted, 25)
+	s.P50 = percentileSortedFloat(sorted, 50)
+	s.P75 = percentileSortedFloat(sorted, 75)
+	return s
+}
+
+// SummarySafe holds descriptive statistics of a set of decimal values
+type SummarySafe struct {
+	Count int
+	Sum   decimal.Decimal
+	Mean  decimal.Decimal
+	Std   decimal.Decimal // sample standard deviation (n-1)
+	Min   decimal.Decimal
+	Max   decimal.Decimal
+	P25   decimal.Decimal
+	P50   decimal.Decimal
+	P75   decimal.Decimal
+}
+
+// DescribeSafe is the decimal counterpart of Describe. It sorts a copy of ds once
+// and derives the extremes and quartiles from it.
+func DescribeSafe(ds ...decimal.Decimal) SummarySafe {
+	if len(ds) == 0 {
+		return SummarySafe{}
+	}
+	sorted := sortedDecimals(ds)
+	s := SummarySafe{
+		Count: len(ds),
+		Sum:   sumDecimals(ds),
+		Min:   sorted[0],
+		Max:   sorted[len(sorted)-1],
+		P25:   percentileSorted(sorted, 25),
+		P50:   percentileSorted(sorted, 50),
+		P75:   percentileSorted(sorted, 75),
+	}
+	s.Mean = s.Sum.DivRound(decimal.NewFromInt(int64(s.Count)), statsPrecision)
+	if s.Count > 1 {
+		sq := decimal.Zero
+		for _, d := range ds {
+			diff := d.Sub(s.Mean)
+			sq = sq.Add(diff.Mul(diff))
+		}
+		s.Std = sqrtDecimal(sq.DivRound(decimal.NewFromInt(int64(s.Count-1)), statsPrecision), statsPrecision)
+	}
+	return s
+}
+
+// sortedFloats returns a sorted float64 copy of ns
+func sortedFloats[T constraints.Integer | constraints.Float](ns []T) []float64 {
+	sorted := make([]float64, len(ns))
+	for i, n := range ns {
+		sorted[i] = float64(n)
+	}
+	slices.Sort(sorted)
+	return sorted
+}
+
+// percentileSortedFloat interpolates the p-th percentile of non-empty sorted values
+func percentileSortedFloat(sorted []float64, p float64) float64 {
+	if math.IsNaN(p) {
+		return math.NaN()
+	}
+	rank := Clamp(p, 0, 100) / 100 * float64(len(sorted)-1)
+	lo := int(rank)
+	frac := rank - float64(lo)
+	if frac == 0 || lo+1 >= len(sorted) {
+		return sorted[lo]
+	}
+	return sorted[lo] + (sorted[lo+1]-sorted[lo])*frac
+}
@@ -0,0 +1,372 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func decimals(values ...string) []decimal.Decimal {
+	ds := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		ds[i] = decimal.RequireFromString(v)
+	}
+	return ds
+}
+
+func TestMedianSafe(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []decimal.Decimal
+		expected string
+	}{
+		{"odd count", decimals("3", "1", "2"), "2"},
+		{"even count", decimals("4", "1", "3", "2"), "2.5"},
+		{"single", decimals("9.99"), "9.99"},
+		{"empty", nil, "0"},
+		{"precise", decimals("0.1", "0.2"), "0.15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MedianSafe(tt.values...); !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("MedianSafe() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPercentileSafe(t *testing.T) {
+	values := decimals("15", "20", "35", "40", "50")
+	tests := []struct {
+		name     string
+		p        float64
+		expected string
+	}{
+		{"minimum", 0, "15"},
+		{"maximum", 100, "50"},
+		{"25th", 25, "20"},
+		{"40th", 40, "29"},
+		{"75th", 75, "40"},
+		{"clamped above", 150, "50"},
+		{"clamped below", -5, "15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PercentileSafe(tt.p, values...)
+			if err != nil || !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("PercentileSafe() = %v, %v, want %v", got, err, tt.expected)
+			}
+		})
+	}
+
+	if got, err := PercentileSafe(50); err != nil || !got.IsZero() {
+		t.Errorf("PercentileSafe() of empty input = %v, %v, want 0", got, err)
+	}
+	for _, p := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := PercentileSafe(p, values...); !errors.Is(err, ErrNotFinite) {
+			t.Errorf("PercentileSafe(%v) error = %v, want ErrNotFinite", p, err)
+		}
+	}
+}
+
+func TestVarianceSafe(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []decimal.Decimal
+		expected string
+	}{
+		{"simple", decimals("1", "2", "3", "4", "5"), "2.5"},
+		{"money", decimals("0.10", "0.20", "0.30"), "0.01"},
+		{"single", decimals("42"), "0"},
+		{"empty", nil, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VarianceSafe(tt.values...); !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("VarianceSafe() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStandardDeviationSafe(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []decimal.Decimal
+		expected string
+	}{
+		{"simple", decimals("1", "2", "3", "4", "5"), "1.58113883008418966599944677221635"},
+		{"exact", decimals("0.10", "0.20", "0.30"), "0.1"},
+		{"single", decimals("42"), "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StandardDeviationSafe(tt.values...)
+			if !got.Round(30).Equal(decimal.RequireFromString(tt.expected).Round(30)) {
+				t.Errorf("StandardDeviationSafe() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{"odd count", []float64{3, 1, 2}, 2},
+		{"even count", []float64{4, 1, 3, 2}, 2.5},
+		{"single", []float64{7}, 7},
+		{"empty", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Median(tt.values...); got != tt.expected {
+				t.Errorf("Median() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if got := Median(5, 1, 9, 3); got != 4 {
+		t.Errorf("Median() of ints = %v, want 4", got)
+	}
+}
+
+func TestAbsoluteDeviation(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		mean   float64
+		median float64
+	}{
+		{"simple", []float64{1, 2, 3, 4, 5}, 1.2, 1},
+		{"outlier", []float64{1, 1, 2, 2, 4, 6, 9}, 116.0 / 49, 1},
+		{"constant", []float64{3, 3, 3}, 0, 0},
+		{"single", []float64{7}, 0, 0},
+		{"empty", nil, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MeanAbsoluteDeviation(tt.values...); math.Abs(got-tt.mean) > 1e-12 {
+				t.Errorf("MeanAbsoluteDeviation() = %v, want %v", got, tt.mean)
+			}
+			if got := MedianAbsoluteDeviation(tt.values...); got != tt.median {
+				t.Errorf("MedianAbsoluteDeviation() = %v, want %v", got, tt.median)
+			}
+			if got := NormalizedMAD(tt.values...); got != MADConsistency*tt.median {
+				t.Errorf("NormalizedMAD() = %v, want %v", got, MADConsistency*tt.median)
+			}
+		})
+	}
+
+	if got := MedianAbsoluteDeviation(2, 4, 6, 100); got != 2 {
+		t.Errorf("MedianAbsoluteDeviation() of ints = %v, want 2", got)
+	}
+}
+
+func TestNanStats(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		name                     string
+		values                   []float64
+		sum, mean, max, min, std float64
+	}{
+		{"with NaN", []float64{2, nan, 4, nan, 9}, 15, 5, 9, 2, math.Sqrt(13)},
+		{"negative", []float64{nan, -3, -1}, -4, -2, -1, -3, math.Sqrt2},
+		{"single", []float64{nan, 7}, 7, 7, 7, 7, 0},
+		{"all NaN", []float64{nan, nan}, 0, 0, 0, 0, 0},
+		{"empty", nil, 0, 0, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []float64{NanSum(tt.values...), NanMean(tt.values...), NanMax(tt.values...), NanMin(tt.values...), NanStd(tt.values...)}
+			want := []float64{tt.sum, tt.mean, tt.max, tt.min, tt.std}
+			if !floatsEqual(got, want, 1e-12) {
+				t.Errorf("NanSum, NanMean, NanMax, NanMin, NanStd = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMedianE(t *testing.T) {
+	if got, err := MedianE(-3, -1, -2); got != -2 || err != nil {
+		t.Errorf("MedianE() = %v, %v, want -2, nil", got, err)
+	}
+	if _, err := MedianE[float64](); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("MedianE() error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{15, 20, 35, 40, 50}
+	tests := []struct {
+		name     string
+		p        float64
+		expected float64
+	}{
+		{"minimum", 0, 15},
+		{"maximum", 100, 50},
+		{"40th", 40, 29},
+		{"90th", 90, 46},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Percentile(tt.p, values...); math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("Percentile() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if got := Percentile(math.NaN(), values...); !math.IsNaN(got) {
+		t.Errorf("Percentile(NaN) = %v, want NaN", got)
+	}
+	if got := Percentile(math.Inf(1), values...); got != 50 {
+		t.Errorf("Percentile(+Inf) = %v, want 50", got)
+	}
+}
+
+func TestQuartilesIQR(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     []float64
+		q1, q2, q3 float64
+	}{
+		{"odd count", []float64{7, 1, 3, 5, 9}, 3, 5, 7},
+		{"even count", []float64{1, 2, 3, 4}, 1.75, 2.5, 3.25},
+		{"single", []float64{4}, 4, 4, 4},
+		{"empty", nil, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q1, q2, q3 := Quartiles(tt.values...)
+			if q1 != tt.q1 || q2 != tt.q2 || q3 != tt.q3 {
+				t.Errorf("Quartiles() = %v, %v, %v, want %v, %v, %v", q1, q2, q3, tt.q1, tt.q2, tt.q3)
+			}
+			if got := IQR(tt.values...); got != tt.q3-tt.q1 {
+				t.Errorf("IQR() = %v, want %v", got, tt.q3-tt.q1)
+			}
+		})
+	}
+
+	if got := IQR(1, 2, 3, 4, 5, 6, 7, 8, 9); got != 4 {
+		t.Errorf("IQR() of ints = %v, want 4", got)
+	}
+}
+
+func TestBoxPlotFences(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 100}
+	lower, upper := BoxPlotFences(values, 1.5)
+	if lower != -3.5 || upper != 14.5 {
+		t.Errorf("BoxPlotFences(1.5) = %v, %v, want -3.5, 14.5", lower, upper)
+	}
+	lower, upper = BoxPlotFences(values, 3)
+	if lower != -10.25 || upper != 21.25 {
+		t.Errorf("BoxPlotFences(3) = %v, %v, want -10.25, 21.25", lower, upper)
+	}
+}
+
+func TestECDF(t *testing.T) {
+	values := []float64{10, 20, 20, 30, math.NaN(), 40}
+	cdf := ECDF(values)
+	sf := SurvivalFunction(values)
+	values[0] = 1000 // the functions keep their own copy
+
+	tests := []struct {
+		x        float64
+		cdf      float64
+		survival float64
+	}{
+		{5, 0, 1},
+		{10, 0.2, 0.8},
+		{19.99, 0.2, 0.8},
+		{20, 0.6, 0.4},
+		{35, 0.8, 0.2},
+		{40, 1, 0},
+		{math.Inf(1), 1, 0},
+		{math.Inf(-1), 0, 1},
+	}
+
+	for _, tt := range tests {
+		if got := cdf(tt.x); got != tt.cdf {
+			t.Errorf("ECDF(%v) = %v, want %v", tt.x, got, tt.cdf)
+		}
+		if got := sf(tt.x); got != tt.survival {
+			t.Errorf("SurvivalFunction(%v) = %v, want %v", tt.x, got, tt.survival)
+		}
+	}
+
+	if got := ECDF(nil)(1); got != 0 {
+		t.Errorf("ECDF(nil)(1) = %v, want 0", got)
+	}
+	if got := SurvivalFunction(nil)(1); got != 0 {
+		t.Errorf("SurvivalFunction(nil)(1) = %v, want 0", got)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	s := Describe(1, 2, 3, 4, 5)
+	expected := Summary{Count: 5, Sum: 15, Mean: 3, Std: 1.5811388300841898, Min: 1, Max: 5, P25: 2, P50: 3, P75: 4}
+	if s.Count != expected.Count || s.Sum != expected.Sum || s.Mean != expected.Mean ||
+		math.Abs(s.Std-expected.Std) > 1e-12 || s.Min != expected.Min || s.Max != expected.Max ||
+		s.P25 != expected.P25 || s.P50 != expected.P50 || s.P75 != expected.P75 {
+		t.Errorf("Describe() = %+v, want %+v", s, expected)
+	}
+
+	if got := Describe(); got != (Summary{}) {
+		t.Errorf("Describe() of empty input = %+v, want zero Summary", got)
+	}
+	if got := Describe(-2); got.Min != -2 || got.Max != -2 || got.Std != 0 || got.P50 != -2 {
+		t.Errorf("Describe(-2) = %+v", got)
+	}
+}
+
+func TestDescribeSafe(t *testing.T) {
+	s := DescribeSafe(decimals("0.30", "0.10", "0.20", "0.40")...)
+	checks := []struct {
+		name     string
+		got      decimal.Decimal
+		expected string
+	}{
+		{"Sum", s.Sum, "1"},
+		{"Mean", s.Mean, "0.25"},
+		{"Min", s.Min, "0.1"},
+		{"Max", s.Max, "0.4"},
+		{"P25", s.P25, "0.175"},
+		{"P50", s.P50, "0.25"},
+		{"P75", s.P75, "0.325"},
+	}
+	for _, c := range checks {
+		if !c.got.Equal(decimal.RequireFromString(c.expected)) {
+			t.Errorf("DescribeSafe().%s = %v, want %v", c.name, c.got, c.expected)
+		}
+	}
+	if s.Count != 4 {
+		t.Errorf("DescribeSafe().Count = %v, want 4", s.Count)
+	}
+	if !s.Std.Equal(StandardDeviationSafe(decimals("0.30", "0.10", "0.20", "0.40")...)) {
+		t.Errorf("DescribeSafe().Std = %v, want StandardDeviationSafe", s.Std)
+	}
+
+	if got := DescribeSafe(); got.Count != 0 {
+		t.Errorf("DescribeSafe() of empty input = %+v", got)
+	}
+
+	// variances beyond the float64 range must not panic
+	huge := DescribeSafe(decimals("1e400", "-1e400")...)
+	sqrt2 := decimal.RequireFromString("1.4142135623730950488")
+	if got := huge.Std.Shift(-400).Round(19); !got.Equal(sqrt2) {
+		t.Errorf("DescribeSafe(1e400, -1e400).Std = %v, want sqrt(2)e400", huge.Std)
+	}
+}
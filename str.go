@@ -0,0 +1,63 @@
+package mathx
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// The Str functions parse their operands as exact decimal strings, so inputs are not
+// limited to the ~15-17 significant digits a float64 can carry.
+
+// AddStr adds two decimal strings and returns a Result
+func AddStr(a, b string) (Result, error) {
+	da, db, err := parseOperands(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	return AddSafe(da, db), nil
+}
+
+// SubStr subtracts two decimal strings and returns a Result
+func SubStr(a, b string) (Result, error) {
+	da, db, err := parseOperands(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	return SubSafe(da, db), nil
+}
+
+// MulStr multiplies two decimal strings and returns a Result
+func MulStr(a, b string) (Result, error) {
+	da, db, err := parseOperands(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	return MulSafe(da, db), nil
+}
+
+// DivStr divides two decimal strings with specified precision and returns a Result.
+// It returns ErrDivisionByZero if b is zero.
+func DivStr(a, b string, precision int32) (Result, error) {
+	da, db, err := parseOperands(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	if db.IsZero() {
+		return Result{}, ErrDivisionByZero
+	}
+	return DivSafe(da, db, precision), nil
+}
+
+// parseOperands parses two decimal strings
+func parseOperands(a, b string) (decimal.Decimal, decimal.Decimal, error) {
+	da, err := decimal.NewFromString(a)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: %q", ErrInvalidFormat, a)
+	}
+	db, err := decimal.NewFromString(b)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: %q", ErrInvalidFormat, b)
+	}
+	return da, db, nil
+}
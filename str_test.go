@@ -0,0 +1,46 @@
+package mathx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStrArithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(a, b string) (Result, error)
+		a        string
+		b        string
+		expected string
+	}{
+		{"AddStr beyond float64", AddStr, "1234567890123456.789", "0.001", "1234567890123456.79"},
+		{"AddStr small", AddStr, "0.1", "0.2", "0.3"},
+		{"SubStr", SubStr, "1.0", "0.9", "0.1"},
+		{"MulStr", MulStr, "12345678901234567890", "10", "123456789012345678900"},
+		{"DivStr", func(a, b string) (Result, error) { return DivStr(a, b, 4) }, "1", "3", "0.3333"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStrArithmetic_errors(t *testing.T) {
+	if _, err := AddStr("abc", "1"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("AddStr() error = %v, want ErrInvalidFormat for invalid first operand", err)
+	}
+	if _, err := MulStr("1", "1.2.3"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("MulStr() error = %v, want ErrInvalidFormat for invalid second operand", err)
+	}
+	if _, err := DivStr("1", "0.00", 2); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("DivStr() error = %v, want ErrDivisionByZero", err)
+	}
+}
@@ -0,0 +1,227 @@
+package mathx
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// StatsFromReader reads numbers separated by newlines, commas or tabs from r and
+// summarizes them without keeping them in memory. parse converts one trimmed,
+// non-empty field; if it is nil, fields are parsed with strconv.ParseFloat and a
+// malformed field yields ErrInvalidFormat. Count, Sum, Mean, Min and Max are computed
+// as in Describe. Std is a streaming approximation from Welford's one-pass recurrence
+// and may differ from Describe's two-pass value in the last digits. The quartiles are
+// exact for up to five values and estimated with the P² algorithm beyond that.
+func StatsFromReader(r io.Reader, parse func([]byte) (float64, error)) (Summary, error) {
+	var (
+		s             Summary
+		mean, m2      float64
+		p25, p50, p75 = newP2Quantile(0.25), newP2Quantile(0.5), newP2Quantile(0.75)
+	)
+	err := scanFields(r, parse, func(v float64) {
+		if s.Count == 0 {
+			s.Min, s.Max = v, v
+		}
+		s.Count++
+		s.Sum += v
+		s.Min = min(s.Min, v)
+		s.Max = max(s.Max, v)
+		// Welford's online update of the mean and the sum of squared deviations
+		delta := v - mean
+		mean += delta / float64(s.Count)
+		m2 += delta * (v - mean)
+		p25.add(v)
+		p50.add(v)
+		p75.add(v)
+	})
+	if err != nil || s.Count == 0 {
+		return Summary{}, err
+	}
+	s.Mean = s.Sum / float64(s.Count)
+	if s.Count > 1 {
+		s.Std = math.Sqrt(m2 / float64(s.Count-1))
+	}
+	s.P25, s.P50, s.P75 = p25.value(), p50.value(), p75.value()
+	return s, nil
+}
+
+// SumReader reads numbers like StatsFromReader and returns their exact decimal sum
+func SumReader(r io.Reader, parse func([]byte) (float64, error)) (Result, error) {
+	sum := decimal.Zero
+	err := scanFields(r, parse, func(v float64) {
+		sum = sum.Add(decimal.NewFromFloat(v))
+	})
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{v: sum}, nil
+}
+
+// scanFields calls fn with every parsed field read from r
+func scanFields(r io.Reader, parse func([]byte) (float64, error), fn func(float64)) error {
+	sc := bufio.NewScanner(r)
+	sc.Split(scanNumberFields)
+	for sc.Scan() {
+		field := bytes.TrimSpace(sc.Bytes())
+		if len(field) == 0 {
+			continue
+		}
+		var v float64
+		var err error
+		if parse != nil {
+			v, err = parse(field)
+		} else if v, err = strconv.ParseFloat(string(field), 64); err != nil {
+			err = fmt.Errorf("%w: %q", ErrInvalidFormat, field)
+		}
+		if err != nil {
+			return err
+		}
+		fn(v)
+	}
+	return sc.Err()
+}
+
+// scanNumberFields is a bufio.SplitFunc that splits on newlines, commas and tabs
+func scanNumberFields(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if i := bytes.IndexAny(data, "\n,\t"); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// p2Quantile estimates a quantile in constant memory with the P² algorithm of
+// Jain and Chlamtac. It keeps the first five values and is exact until then.
+type p2Quantile struct {
+	p     float64
+	count int
+	q     [5]float64 // marker heights
+	n     [5]float64 // actual marker positions
+	np    [5]float64 // desired marker positions
+	dn    [5]float64 // increments of the desired positions
+}
+
+func newP2Quantile(p float64) *p2Quantile {
+	return &p2Quantile{
+		p:  p,
+		np: [5]float64{0, 2 * p, 4 * p, 2 + 2*p, 4},
+		dn: [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}
+}
+
+func (e *p2Quantile) add(x float64) {
+	if e.count < 5 {
+		e.q[e.count] = x
+		e.count++
+		if e.count == 5 {
+			slices.Sort(e.q[:])
+			e.n = [5]float64{0, 1, 2, 3, 4}
+		}
+		return
+	}
+	e.count++
+
+	var k int
+	switch {
+	case x < e.q[0]:
+		e.q[0] = x
+		k = 0
+	case x >= e.q[4]:
+		e.q[4] = max(e.q[4], x)
+		k = 3
+	default:
+		for k = 0; x >= e.q[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		e.n[i]++
+	}
+	for i := range e.np {
+		e.np[i] += e.dn[i]
+	}
+
+	for i := 1; i <= 3; i++ {
+		d := e.np[i] - e.n[i]
+		if (d >= 1 && e.n[i+1]-e.n[i] > 1) || (d <= -1 && e.n[i-1]-e.n[i] < -1) {
+			d = math.Copysign(1, d)
+			q := e.parabolic(i, d)
+			if q <= e.q[i-1] || q >= e.q[i+1] {
+				j := i + int(d)
+				q = e.q[i] + d*(e.q[j]-e.q[i])/(e.n[j]-e.n[i])
+			}
+			e.q[i] = q
+			e.n[i] += d
+		}
+	}
+}
+
+// parabolic returns the piecewise-parabolic prediction for marker i moved by d
+func (e *p2Quantile) parabolic(i int, d float64) float64 {
+	q, n := e.q, e.n
+	return q[i] + d/(n[i+1]-n[i-1])*
+		((n[i]-n[i-1]+d)*(q[i+1]-q[i])/(n[i+1]-n[i])+
+			(n[i+1]-n[i]-d)*(q[i]-q[i-1])/(n[i]-n[i-1]))
+}
+
+// value returns the current quantile estimate
+func (e *p2Quantile) value() float64 {
+	if e.count == 0 {
+		return 0
+	}
+	if e.count <= 5 {
+		sorted := slices.Clone(e.q[:e.count])
+		slices.Sort(sorted)
+		return percentileSortedFloat(sorted, e.p*100)
+	}
+	return e.q[2]
+}
+
+// MinMaxTracker keeps the extremes of a stream of values without storing them, a
+// cheaper alternative to StatsFromReader when only the range matters. NaN values are
+// ignored. The zero value is an empty tracker ready to use.
+type MinMaxTracker struct {
+	count    int
+	min, max float64
+}
+
+// Observe records v
+func (t *MinMaxTracker) Observe(v float64) {
+	if math.IsNaN(v) {
+		return
+	}
+	if t.count == 0 {
+		t.min, t.max = v, v
+	}
+	t.count++
+	t.min = min(t.min, v)
+	t.max = max(t.max, v)
+}
+
+// Count returns how many values have been observed
+func (t *MinMaxTracker) Count() int {
+	return t.count
+}
+
+// Min returns the smallest observed value, or 0 if nothing has been observed
+func (t *MinMaxTracker) Min() float64 {
+	return t.min
+}
+
+// Max returns the largest observed value, or 0 if nothing has been observed
+func (t *MinMaxTracker) Max() float64 {
+	return t.max
+}
+
+// Range returns Max() - Min(), or 0 if nothing has been observed
+func (t *MinMaxTracker) Range() float64 {
+	return t.max - t.min
+}
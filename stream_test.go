@@ -0,0 +1,134 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestStatsFromReader(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		values []float64
+	}{
+		{"newlines", "1\n2\n3\n4\n", []float64{1, 2, 3, 4}},
+		{"csv with spaces", "1.5, 2.5,\t-3\r\n4, 10", []float64{1.5, 2.5, -3, 4, 10}},
+		{"single", "42", []float64{42}},
+		{"blank fields", "\n\n7,,8\n\n", []float64{7, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StatsFromReader(strings.NewReader(tt.input), nil)
+			if err != nil {
+				t.Fatalf("StatsFromReader() error = %v", err)
+			}
+			want := Describe(tt.values...)
+			if got.Count != want.Count || got.Sum != want.Sum || got.Mean != want.Mean || got.Min != want.Min || got.Max != want.Max {
+				t.Errorf("StatsFromReader() = %+v, want %+v", got, want)
+			}
+			for _, pair := range [][2]float64{{got.Std, want.Std}, {got.P25, want.P25}, {got.P50, want.P50}, {got.P75, want.P75}} {
+				if math.Abs(pair[0]-pair[1]) > 1e-12 {
+					t.Errorf("StatsFromReader() = %+v, want %+v", got, want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestStatsFromReader_large(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	values := make([]float64, 10001)
+	for i := range values {
+		values[i] = float64(i)
+	}
+	rng.Shuffle(len(values), func(i, j int) { values[i], values[j] = values[j], values[i] })
+
+	var sb strings.Builder
+	for _, v := range values {
+		sb.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+		sb.WriteByte('\n')
+	}
+	got, err := StatsFromReader(strings.NewReader(sb.String()), nil)
+	if err != nil {
+		t.Fatalf("StatsFromReader() error = %v", err)
+	}
+
+	want := Describe(values...)
+	if got.Count != want.Count || got.Sum != want.Sum || got.Min != 0 || got.Max != 10000 {
+		t.Errorf("StatsFromReader() = %+v, want %+v", got, want)
+	}
+	if got.Mean != want.Mean || math.Abs(got.Std-want.Std) > 1e-9 {
+		t.Errorf("Mean/Std = %v/%v, want %v/%v", got.Mean, got.Std, want.Mean, want.Std)
+	}
+	// the quartiles are estimates; allow 1% of the range
+	for _, pair := range [][2]float64{{got.P25, 2500}, {got.P50, 5000}, {got.P75, 7500}} {
+		if math.Abs(pair[0]-pair[1]) > 100 {
+			t.Errorf("quartile estimate %v, want about %v", pair[0], pair[1])
+		}
+	}
+}
+
+func TestStatsFromReader_errors(t *testing.T) {
+	if _, err := StatsFromReader(strings.NewReader("1\nabc\n3"), nil); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("StatsFromReader() error = %v, want ErrInvalidFormat", err)
+	}
+
+	errBad := errors.New("bad field")
+	parse := func(b []byte) (float64, error) {
+		if string(b) == "x" {
+			return 0, errBad
+		}
+		r, err := ParseMoney(string(b))
+		return r.Float64(), err
+	}
+	if _, err := StatsFromReader(strings.NewReader("1\nx"), parse); !errors.Is(err, errBad) {
+		t.Errorf("StatsFromReader() error = %v, want %v", err, errBad)
+	}
+
+	s, err := StatsFromReader(strings.NewReader(""), nil)
+	if err != nil || s != (Summary{}) {
+		t.Errorf("StatsFromReader(empty) = %+v, %v, want zero Summary", s, err)
+	}
+}
+
+func TestSumReader(t *testing.T) {
+	got, err := SumReader(strings.NewReader("0.1\n0.2\n0.3"), nil)
+	if err != nil {
+		t.Fatalf("SumReader() error = %v", err)
+	}
+	if got.String() != "0.6" {
+		t.Errorf("SumReader() = %v, want 0.6", got)
+	}
+
+	if _, err := SumReader(strings.NewReader("1,two"), nil); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("SumReader() error = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestMinMaxTracker(t *testing.T) {
+	var tr MinMaxTracker
+	if tr.Count() != 0 || tr.Min() != 0 || tr.Max() != 0 || tr.Range() != 0 {
+		t.Errorf("empty tracker = %d, %v, %v, %v, want zeros", tr.Count(), tr.Min(), tr.Max(), tr.Range())
+	}
+	for _, v := range []float64{-2.5, 7, math.NaN(), 3, -4, 6.5} {
+		tr.Observe(v)
+	}
+	if tr.Count() != 5 {
+		t.Errorf("Count() = %d, want 5", tr.Count())
+	}
+	if tr.Min() != -4 || tr.Max() != 7 || tr.Range() != 11 {
+		t.Errorf("Min, Max, Range = %v, %v, %v, want -4, 7, 11", tr.Min(), tr.Max(), tr.Range())
+	}
+
+	var single MinMaxTracker
+	single.Observe(-3)
+	if single.Min() != -3 || single.Max() != -3 || single.Range() != 0 {
+		t.Errorf("single value tracker = %v, %v, %v, want -3, -3, 0", single.Min(), single.Max(), single.Range())
+	}
+}
@@ -0,0 +1,49 @@
+package mathx
+
+import "github.com/shopspring/decimal"
+
+// RoundTarget tells Tip how to round up. With a zero Step the tip is only rounded
+// to cents; otherwise the tip, or the grand total when Total is set, is rounded up
+// to a multiple of Step.
+type RoundTarget struct {
+	Step  float64
+	Total bool
+}
+
+// Common rounding targets for Tip
+var (
+	// RoundTipCents rounds the tip to cents only
+	RoundTipCents = RoundTarget{}
+	// RoundTipUpDollar rounds the tip up to a whole unit
+	RoundTipUpDollar = RoundTarget{Step: 1}
+	// RoundTotalUpDollar rounds the grand total up to a whole unit
+	RoundTotalUpDollar = RoundTarget{Step: 1, Total: true}
+)
+
+// TipSummary is the result of Tip
+type TipSummary struct {
+	Tip        Result
+	GrandTotal Result
+}
+
+// Tip computes a tip or service charge of percent (e.g. 15 for 15%) on total,
+// rounded to cents and then rounded up according to roundTo
+func Tip(total, percent float64, roundTo RoundTarget) TipSummary {
+	t := decimal.NewFromFloat(total)
+	tip := t.Mul(decimal.NewFromFloat(percent)).Shift(-2).Round(billPlaces)
+	if roundTo.Step > 0 {
+		step := decimal.NewFromFloat(roundTo.Step)
+		if roundTo.Total {
+			tip = quantizeDecimal(t.Add(tip), step, decimal.Zero, RoundCeiling).Sub(t)
+		} else {
+			tip = quantizeDecimal(tip, step, decimal.Zero, RoundCeiling)
+		}
+	}
+	return TipSummary{Tip: Result{v: tip}, GrandTotal: Result{v: t.Add(tip)}}
+}
+
+// PerPerson splits the grand total between n people as SplitBill does,
+// with the first people covering any leftover cents
+func (s TipSummary) PerPerson(n int) []Result {
+	return SplitBillSafe(s.GrandTotal.Decimal(), n, RoundDown)
+}
@@ -0,0 +1,49 @@
+package mathx
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTip(t *testing.T) {
+	tests := []struct {
+		name       string
+		total      float64
+		percent    float64
+		roundTo    RoundTarget
+		tip        string
+		grandTotal string
+	}{
+		{"cents", 47.8, 15, RoundTipCents, "7.17", "54.97"},
+		{"tip up to dollar", 47.8, 15, RoundTipUpDollar, "8", "55.8"},
+		{"total up to dollar", 47.8, 15, RoundTotalUpDollar, "7.2", "55"},
+		{"already whole", 40, 25, RoundTotalUpDollar, "10", "50"},
+		{"half dollar", 47.8, 18, RoundTarget{Step: 0.5}, "9", "56.8"},
+		{"zero percent", 12.34, 0, RoundTipCents, "0", "12.34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tip(tt.total, tt.percent, tt.roundTo)
+			if got.Tip.String() != tt.tip || got.GrandTotal.String() != tt.grandTotal {
+				t.Errorf("Tip() = %v/%v, want %v/%v", got.Tip, got.GrandTotal, tt.tip, tt.grandTotal)
+			}
+		})
+	}
+}
+
+func TestTipSummary_PerPerson(t *testing.T) {
+	s := Tip(47.8, 15, RoundTipCents)
+	if got := fmt.Sprint(s.PerPerson(3)); got != "[18.33 18.32 18.32]" {
+		t.Errorf("PerPerson(3) = %v, want [18.33 18.32 18.32]", got)
+	}
+	if got := s.PerPerson(0); got != nil {
+		t.Errorf("PerPerson(0) = %v, want nil", got)
+	}
+
+	// a grand total beyond float64 precision keeps every cent
+	large := TipSummary{GrandTotal: MustResultFromString("1234567890123456.79")}
+	if got := fmt.Sprint(large.PerPerson(2)); got != "[617283945061728.4 617283945061728.39]" {
+		t.Errorf("PerPerson(2) of a large total = %v, want [617283945061728.4 617283945061728.39]", got)
+	}
+}
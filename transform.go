@@ -0,0 +1,45 @@
+package mathx
+
+import "math"
+
+// Sigmoid returns the logistic function 1 / (1 + e^-x), which maps any score to (0, 1).
+// It is evaluated without overflow for large |x|.
+func Sigmoid(x float64) float64 {
+	if x >= 0 {
+		return 1 / (1 + math.Exp(-x))
+	}
+	e := math.Exp(x)
+	return e / (1 + e)
+}
+
+// Logit is the inverse of Sigmoid: ln(p / (1 - p)). It returns -Inf and +Inf for
+// p = 0 and p = 1, and ErrDomain if p lies outside [0, 1] or is NaN.
+func Logit(p float64) (float64, error) {
+	if !(p >= 0 && p <= 1) {
+		return 0, ErrDomain
+	}
+	return math.Log(p) - math.Log1p(-p), nil
+}
+
+// SoftClamp is a smooth alternative to Clamp: it maps any value into the open interval
+// (min, max) with a tanh curve that is the identity near the midpoint and approaches
+// the bounds asymptotically. It returns the midpoint if max <= min.
+func SoftClamp(value, min, max float64) float64 {
+	mid := min + (max-min)/2
+	half := (max - min) / 2
+	if !(half > 0) {
+		return mid
+	}
+	return mid + half*math.Tanh((value-mid)/half)
+}
+
+// InverseSoftClamp is the inverse of SoftClamp. It returns ErrDomain if y lies outside
+// the open interval (min, max) or max <= min.
+func InverseSoftClamp(y, min, max float64) (float64, error) {
+	if !(max > min) || !(y > min && y < max) {
+		return 0, ErrDomain
+	}
+	mid := min + (max-min)/2
+	half := (max - min) / 2
+	return mid + half*math.Atanh((y-mid)/half), nil
+}
@@ -0,0 +1,89 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSigmoidLogit(t *testing.T) {
+	tests := []struct {
+		x        float64
+		expected float64
+	}{
+		{0, 0.5},
+		{math.Log(3), 0.75},
+		{-math.Log(3), 0.25},
+		{1000, 1},
+		{-1000, 0},
+		{math.Inf(1), 1},
+		{math.Inf(-1), 0},
+	}
+
+	for _, tt := range tests {
+		if got := Sigmoid(tt.x); math.Abs(got-tt.expected) > 1e-15 {
+			t.Errorf("Sigmoid(%v) = %v, want %v", tt.x, got, tt.expected)
+		}
+	}
+
+	for _, x := range []float64{-20, -3.5, -0.1, 0, 0.1, 3.5, 10} {
+		got, err := Logit(Sigmoid(x))
+		if err != nil || math.Abs(got-x) > 1e-9 {
+			t.Errorf("Logit(Sigmoid(%v)) = %v, %v, want %v, nil", x, got, err, x)
+		}
+	}
+	if got, err := Logit(0); !math.IsInf(got, -1) || err != nil {
+		t.Errorf("Logit(0) = %v, %v, want -Inf, nil", got, err)
+	}
+	if got, err := Logit(1); !math.IsInf(got, 1) || err != nil {
+		t.Errorf("Logit(1) = %v, %v, want +Inf, nil", got, err)
+	}
+	for _, p := range []float64{-0.1, 1.1, math.NaN()} {
+		if _, err := Logit(p); !errors.Is(err, ErrDomain) {
+			t.Errorf("Logit(%v) error = %v, want ErrDomain", p, err)
+		}
+	}
+}
+
+func TestSoftClamp(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         float64
+		min, max      float64
+		expected      float64
+		tolerance     float64
+		strictlyInner bool
+	}{
+		{"midpoint", 5, 0, 10, 5, 0, true},
+		{"near midpoint", 5.01, 0, 10, 5.01, 1e-6, true},
+		{"far above", 1e6, 0, 10, 10, 1e-12, false},
+		{"far below", -1e6, 0, 10, 0, 1e-12, false},
+		{"inside", 8, 0, 10, 5 + 5*math.Tanh(0.6), 1e-12, true},
+		{"empty range", 3, 2, 2, 2, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SoftClamp(tt.value, tt.min, tt.max)
+			if math.Abs(got-tt.expected) > tt.tolerance {
+				t.Errorf("SoftClamp() = %v, want %v", got, tt.expected)
+			}
+			if !tt.strictlyInner {
+				return
+			}
+			back, err := InverseSoftClamp(got, tt.min, tt.max)
+			if err != nil || math.Abs(back-tt.value) > 1e-9 {
+				t.Errorf("InverseSoftClamp() = %v, %v, want %v, nil", back, err, tt.value)
+			}
+		})
+	}
+
+	for _, y := range []float64{0, 10, -1, math.NaN()} {
+		if _, err := InverseSoftClamp(y, 0, 10); !errors.Is(err, ErrDomain) {
+			t.Errorf("InverseSoftClamp(%v) error = %v, want ErrDomain", y, err)
+		}
+	}
+	if _, err := InverseSoftClamp(1, 2, 2); !errors.Is(err, ErrDomain) {
+		t.Errorf("InverseSoftClamp() on empty range error = %v, want ErrDomain", err)
+	}
+}
@@ -0,0 +1,192 @@
+// Package units converts between units of length, mass, temperature, area, volume
+// and speed. Conversions are carried out with decimal arithmetic and a single
+// division, so chained or round-trip conversions do not accumulate float drift.
+package units
+
+import (
+	"errors"
+	"math"
+
+	"github.com/go4x/mathx"
+	"github.com/shopspring/decimal"
+)
+
+// Precision is the number of decimal places kept by conversions that need a division
+const Precision = 20
+
+// ErrIncompatibleUnits is returned when converting between units of different dimensions
+var ErrIncompatibleUnits = errors.New("units: incompatible units")
+
+// ErrInvalidUnit is returned when converting from or to a Unit that was not created by
+// this package, such as the zero Unit{}
+var ErrInvalidUnit = errors.New("units: invalid unit")
+
+// Dimension is the physical quantity a unit measures
+type Dimension int
+
+const (
+	Length Dimension = iota
+	Mass
+	Temperature
+	Area
+	Volume
+	Speed
+)
+
+// String returns the name of the dimension
+func (d Dimension) String() string {
+	switch d {
+	case Length:
+		return "length"
+	case Mass:
+		return "mass"
+	case Temperature:
+		return "temperature"
+	case Area:
+		return "area"
+	case Volume:
+		return "volume"
+	case Speed:
+		return "speed"
+	}
+	return "unknown"
+}
+
+// Unit is a unit of measurement. A value v in this unit equals
+// (v + offset) * num / den in the base unit of its dimension.
+type Unit struct {
+	name   string
+	symbol string
+	dim    Dimension
+	num    decimal.Decimal
+	den    decimal.Decimal
+	offset decimal.Decimal
+}
+
+// newUnit creates a unit whose base-unit factor is the exact decimal literal factor
+func newUnit(name, symbol string, dim Dimension, factor string) Unit {
+	return Unit{name: name, symbol: symbol, dim: dim, num: decimal.RequireFromString(factor), den: decimal.New(1, 0)}
+}
+
+// newRatioUnit creates a unit whose base-unit factor is the ratio num/den
+func newRatioUnit(name, symbol string, dim Dimension, num, den int64) Unit {
+	return Unit{name: name, symbol: symbol, dim: dim, num: decimal.NewFromInt(num), den: decimal.NewFromInt(den)}
+}
+
+// Name returns the name of the unit
+func (u Unit) Name() string {
+	return u.name
+}
+
+// Symbol returns the symbol of the unit
+func (u Unit) Symbol() string {
+	return u.symbol
+}
+
+// Dimension returns the dimension the unit measures
+func (u Unit) Dimension() Dimension {
+	return u.dim
+}
+
+// String returns the symbol of the unit
+func (u Unit) String() string {
+	return u.symbol
+}
+
+// Length units, based on the meter
+var (
+	Meter        = newUnit("meter", "m", Length, "1")
+	Kilometer    = newUnit("kilometer", "km", Length, "1000")
+	Centimeter   = newUnit("centimeter", "cm", Length, "0.01")
+	Millimeter   = newUnit("millimeter", "mm", Length, "0.001")
+	Inch         = newUnit("inch", "in", Length, "0.0254")
+	Foot         = newUnit("foot", "ft", Length, "0.3048")
+	Yard         = newUnit("yard", "yd", Length, "0.9144")
+	Mile         = newUnit("mile", "mi", Length, "1609.344")
+	NauticalMile = newUnit("nautical mile", "nmi", Length, "1852")
+)
+
+// Mass units, based on the kilogram
+var (
+	Kilogram  = newUnit("kilogram", "kg", Mass, "1")
+	Gram      = newUnit("gram", "g", Mass, "0.001")
+	Milligram = newUnit("milligram", "mg", Mass, "0.000001")
+	Tonne     = newUnit("tonne", "t", Mass, "1000")
+	Pound     = newUnit("pound", "lb", Mass, "0.45359237")
+	Ounce     = newUnit("ounce", "oz", Mass, "0.028349523125")
+	Stone     = newUnit("stone", "st", Mass, "6.35029318")
+)
+
+// Temperature units, based on the kelvin
+var (
+	Kelvin     = Unit{name: "kelvin", symbol: "K", dim: Temperature, num: decimal.New(1, 0), den: decimal.New(1, 0)}
+	Celsius    = Unit{name: "celsius", symbol: "°C", dim: Temperature, num: decimal.New(1, 0), den: decimal.New(1, 0), offset: decimal.RequireFromString("273.15")}
+	Fahrenheit = Unit{name: "fahrenheit", symbol: "°F", dim: Temperature, num: decimal.New(5, 0), den: decimal.New(9, 0), offset: decimal.RequireFromString("459.67")}
+)
+
+// Area units, based on the square meter
+var (
+	SquareMeter      = newUnit("square meter", "m²", Area, "1")
+	SquareKilometer  = newUnit("square kilometer", "km²", Area, "1000000")
+	SquareCentimeter = newUnit("square centimeter", "cm²", Area, "0.0001")
+	SquareFoot       = newUnit("square foot", "ft²", Area, "0.09290304")
+	SquareMile       = newUnit("square mile", "mi²", Area, "2589988.110336")
+	Hectare          = newUnit("hectare", "ha", Area, "10000")
+	Acre             = newUnit("acre", "ac", Area, "4046.8564224")
+)
+
+// Volume units, based on the cubic meter
+var (
+	CubicMeter     = newUnit("cubic meter", "m³", Volume, "1")
+	Liter          = newUnit("liter", "L", Volume, "0.001")
+	Milliliter     = newUnit("milliliter", "mL", Volume, "0.000001")
+	CubicFoot      = newUnit("cubic foot", "ft³", Volume, "0.028316846592")
+	GallonUS       = newUnit("US gallon", "gal", Volume, "0.003785411784")
+	QuartUS        = newUnit("US quart", "qt", Volume, "0.000946352946")
+	PintUS         = newUnit("US pint", "pt", Volume, "0.000473176473")
+	FluidOunceUS   = newUnit("US fluid ounce", "fl oz", Volume, "0.0000295735295625")
+	GallonImperial = newUnit("imperial gallon", "imp gal", Volume, "0.00454609")
+)
+
+// Speed units, based on the meter per second
+var (
+	MeterPerSecond   = newUnit("meter per second", "m/s", Speed, "1")
+	KilometerPerHour = newRatioUnit("kilometer per hour", "km/h", Speed, 1000, 3600)
+	MilePerHour      = newUnit("mile per hour", "mph", Speed, "0.44704")
+	Knot             = newRatioUnit("knot", "kn", Speed, 1852, 3600)
+	FootPerSecond    = newUnit("foot per second", "ft/s", Speed, "0.3048")
+)
+
+// Convert converts value from one unit to another of the same dimension.
+// It returns mathx.ErrNotFinite if value is NaN or ±Inf.
+func Convert(value float64, from, to Unit) (mathx.Result, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return mathx.Result{}, mathx.ErrNotFinite
+	}
+	return ConvertSafe(decimal.NewFromFloat(value), from, to)
+}
+
+// ConvertSafe converts a decimal value from one unit to another of the same dimension
+func ConvertSafe(value decimal.Decimal, from, to Unit) (mathx.Result, error) {
+	if from.num.IsZero() || from.den.IsZero() || to.num.IsZero() || to.den.IsZero() {
+		return mathx.Result{}, ErrInvalidUnit
+	}
+	if from.dim != to.dim {
+		return mathx.Result{}, ErrIncompatibleUnits
+	}
+	// (value + from.offset) * from.num / from.den gives the base value,
+	// base * to.den / to.num - to.offset gives the target value
+	numerator := value.Add(from.offset).Mul(from.num).Mul(to.den)
+	denominator := from.den.Mul(to.num)
+	result := numerator.DivRound(denominator, Precision).Sub(to.offset)
+	return mathx.NewResultFromDecimal(result), nil
+}
+
+// MustConvert is like Convert but panics if the conversion fails
+func MustConvert(value float64, from, to Unit) mathx.Result {
+	r, err := Convert(value, from, to)
+	if err != nil {
+		panic("units: MustConvert(" + from.symbol + ", " + to.symbol + "): " + err.Error())
+	}
+	return r
+}
@@ -0,0 +1,100 @@
+package units
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/go4x/mathx"
+	"github.com/shopspring/decimal"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		from     Unit
+		to       Unit
+		expected string
+	}{
+		{"miles to kilometers", 5, Mile, Kilometer, "8.04672"},
+		{"kilometers to miles", 8.04672, Kilometer, Mile, "5"},
+		{"feet to inches", 1, Foot, Inch, "12"},
+		{"pounds to kilograms", 1, Pound, Kilogram, "0.45359237"},
+		{"tonnes to pounds", 1, Tonne, Pound, "2204.62262184877580722974"},
+		{"celsius to fahrenheit", 100, Celsius, Fahrenheit, "212"},
+		{"fahrenheit to celsius", -40, Fahrenheit, Celsius, "-40"},
+		{"fahrenheit to kelvin", 32, Fahrenheit, Kelvin, "273.15"},
+		{"kelvin to celsius", 0, Kelvin, Celsius, "-273.15"},
+		{"acres to hectares", 1, Acre, Hectare, "0.40468564224"},
+		{"gallons to liters", 1, GallonUS, Liter, "3.785411784"},
+		{"fluid ounces per gallon", 1, GallonUS, FluidOunceUS, "128"},
+		{"km/h to m/s", 36, KilometerPerHour, MeterPerSecond, "10"},
+		{"knots to km/h", 1, Knot, KilometerPerHour, "1.852"},
+		{"mph to km/h", 60, MilePerHour, KilometerPerHour, "96.56064"},
+		{"same unit", 3.5, Meter, Meter, "3.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.value, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if !got.Decimal().Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("Convert() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvert_roundTrip(t *testing.T) {
+	value := decimal.RequireFromString("98.6")
+	chain := []Unit{Fahrenheit, Celsius, Kelvin, Fahrenheit, Kelvin, Celsius, Fahrenheit}
+	for i := 1; i < len(chain); i++ {
+		r, err := ConvertSafe(value, chain[i-1], chain[i])
+		if err != nil {
+			t.Fatalf("ConvertSafe() error = %v", err)
+		}
+		value = r.Decimal()
+	}
+	if !value.Round(15).Equal(decimal.RequireFromString("98.6")) {
+		t.Errorf("round trip = %v, want 98.6", value)
+	}
+}
+
+func TestConvert_incompatible(t *testing.T) {
+	if _, err := Convert(1, Meter, Kilogram); !errors.Is(err, ErrIncompatibleUnits) {
+		t.Errorf("Convert() error = %v, want ErrIncompatibleUnits", err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustConvert() did not panic on incompatible units")
+		}
+	}()
+	MustConvert(1, Liter, Celsius)
+}
+
+func TestConvert_invalid(t *testing.T) {
+	if _, err := Convert(math.NaN(), Meter, Foot); !errors.Is(err, mathx.ErrNotFinite) {
+		t.Errorf("Convert(NaN) error = %v, want ErrNotFinite", err)
+	}
+	if _, err := Convert(math.Inf(-1), Celsius, Kelvin); !errors.Is(err, mathx.ErrNotFinite) {
+		t.Errorf("Convert(-Inf) error = %v, want ErrNotFinite", err)
+	}
+	if _, err := Convert(1, Unit{}, Meter); !errors.Is(err, ErrInvalidUnit) {
+		t.Errorf("Convert() from Unit{} error = %v, want ErrInvalidUnit", err)
+	}
+	if _, err := ConvertSafe(decimal.NewFromInt(1), Meter, Unit{}); !errors.Is(err, ErrInvalidUnit) {
+		t.Errorf("ConvertSafe() to Unit{} error = %v, want ErrInvalidUnit", err)
+	}
+}
+
+func TestUnit_accessors(t *testing.T) {
+	if Mile.Name() != "mile" || Mile.Symbol() != "mi" || Mile.Dimension() != Length || Mile.String() != "mi" {
+		t.Errorf("unexpected Mile accessors: %v %v %v", Mile.Name(), Mile.Symbol(), Mile.Dimension())
+	}
+	if Speed.String() != "speed" || Dimension(99).String() != "unknown" {
+		t.Errorf("unexpected Dimension strings")
+	}
+}